@@ -1,7 +1,9 @@
 package arm64
 
 import (
+	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 )
@@ -10,6 +12,17 @@ import (
 type Options struct {
 	StartAddress int64
 	DecimalImm   bool
+	Vendor       Vendor           // decode vendor specific encodings, e.g. Apple AMX
+	ByteOrder    binary.ByteOrder // byte order of the instruction words, binary.LittleEndian if nil
+	Arch         ArchVersion      // report instructions newer than Arch with ErrFeature
+	Features     Features         // optional features implemented in addition to those of Arch
+}
+
+func (o Options) byteOrder() binary.ByteOrder {
+	if o.ByteOrder == nil {
+		return binary.LittleEndian
+	}
+	return o.ByteOrder
 }
 
 // Result Disassemble instruction result
@@ -19,30 +32,117 @@ type Result struct {
 	Error       error
 }
 
+// InstructionResult Instructions decoded instruction result
+type InstructionResult struct {
+	Address     uint64
+	Instruction *Instruction
+	Error       error
+}
+
+// Decode decodes the single instruction word at address addr
+func Decode(word uint32, addr uint64) (*Instruction, error) {
+	var i Instruction
+	if err := DecodeInto(&i, word, addr); err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
+
+// DecodeInto decodes the single instruction word at address addr into the
+// caller owned i, overwriting its previous contents. Errors are a *DecodeError;
+// on error the contents of i are undefined
+func DecodeInto(i *Instruction, word uint32, addr uint64) error {
+	if err := decodeInto(i, Options{}, word, addr); err != nil {
+		return &DecodeError{Raw: word, Address: addr, Group: i.group, Stage: STAGE_DECODE, Err: err}
+	}
+	return nil
+}
+
+// decodeInto decodes word into i and returns the cause of a failure, which
+// callers wrap in a DecodeError
+func decodeInto(i *Instruction, options Options, word uint32, addr uint64) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrInternal, r)
+		}
+	}()
+	if _, err := decompose_vendor_into(i, options.Vendor, word, addr); err != nil {
+		if i.group == GROUP_UNALLOCATED {
+			return &rejectedError{kind: ErrUnallocated, err: err}
+		}
+		return &rejectedError{kind: ErrReserved, err: err}
+	}
+	if i.operation == ARM64_UNDEFINED {
+		if i.group == GROUP_UNALLOCATED {
+			return ErrUnallocated
+		}
+		return ErrReserved
+	}
+	i.setImmediateBits()
+	if options.gated() && !isHint(word) {
+		if missing := i.requiredFeatures() &^ options.profile(); missing != 0 {
+			return fmt.Errorf("%w: %v", ErrFeature, missing)
+		}
+	}
+	return nil
+}
+
+// format returns the disassembly of i and the cause of a failure, which callers
+// wrap in a DecodeError
+func format(i *Instruction, decimalImm bool) (str string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrInternal, r)
+		}
+	}()
+	if str, err = i.disassemble(decimalImm); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInternal, err)
+	}
+	return str, nil
+}
+
 // Disassemble will output the disassembly of the data of a given io.ReadSeeker
 func Disassemble(r io.ReadSeeker, options Options) <-chan Result {
+	return DisassembleContext(context.Background(), r, options)
+}
+
+// DisassembleContext is Disassemble that stops and closes the channel when ctx
+// is done, so consumers can stop receiving early without leaking the decoding
+// goroutine. The channel is closed the same way at the end of the data; check
+// ctx.Err() once it is closed to tell the two apart
+func DisassembleContext(ctx context.Context, r io.ReadSeeker, options Options) <-chan Result {
 
 	out := make(chan Result)
 
+	send := func(res Result) {
+		select {
+		case out <- res:
+		case <-ctx.Done():
+		}
+	}
+
 	go func() {
+		defer close(out)
 		var err error
 		var instrValue uint32
 		for {
+			if ctx.Err() != nil {
+				return
+			}
+
 			addr, _ := r.Seek(0, io.SeekCurrent)
 
-			err = binary.Read(r, binary.LittleEndian, &instrValue)
+			err = binary.Read(r, options.byteOrder(), &instrValue)
 
 			if err == io.EOF {
-				close(out)
-				break
+				return
 			}
 
 			if err != nil {
-				out <- Result{
+				send(Result{
 					Error: fmt.Errorf("failed to read instruction: %v", err),
-				}
-				close(out)
-				break
+				})
+				return
 			}
 
 			if options.StartAddress != 0 {
@@ -51,81 +151,110 @@ func Disassemble(r io.ReadSeeker, options Options) <-chan Result {
 				addr = 0
 			}
 
-			i, err := decompose(instrValue, uint64(addr))
-			if err != nil {
-				if err == failedToDecodeInstruction || err == failedToDisassembleOperation {
-					out <- Result{
-						StrRepr: fmt.Sprintf("%#08x:  %s\t<unknown>", uint64(addr), getOpCodeByteString(instrValue)),
-						Error:   fmt.Errorf("failed to decode instruction: 0x%08x; %v", instrValue, err),
-					}
-				} else {
-					out <- Result{
-						StrRepr: fmt.Sprintf("%#08x:  %s\t💥 ERROR 💥", uint64(addr), getOpCodeByteString(instrValue)),
-						Error:   fmt.Errorf("failed to decode instruction: 0x%08x; %v", instrValue, err),
-					}
-				}
-				continue
-			}
-
-			instruction, err := i.disassemble(options.DecimalImm)
-			if err != nil {
-				out <- Result{
-					StrRepr: fmt.Sprintf("%#08x:  %s\t<unknown>", uint64(addr), getOpCodeByteString(instrValue)),
-					Error:   fmt.Errorf("failed to disassemble instruction: 0x%08x; %v", instrValue, err),
-				}
-				continue
-			}
-
-			out <- Result{
-				StrRepr:     fmt.Sprintf("%#08x:  %s\t%s", uint64(addr), getOpCodeByteString(instrValue), instruction),
-				Instruction: i,
-				Error:       nil,
-			}
+			send(disassembleWord(instrValue, uint64(addr), options))
 		}
-		return
 	}()
 
 	return out
 }
 
-// Instructions will output the decoded instructions of the data of a given io.ReadSeeker
-func Instructions(r io.ReadSeeker, startAddr int64) (<-chan *Instruction, error) {
+// Instructions will output the decoded instructions of the little-endian data
+// of a given io.ReadSeeker, where the reader's current position is at startAddr.
+// Words that fail to decode are reported through InstructionResult.Error and
+// decoding carries on with the next word; the channel is closed at the end of
+// the data or after a read error.
+func Instructions(r io.ReadSeeker, startAddr int64) <-chan InstructionResult {
+	return InstructionsContext(context.Background(), r, Options{StartAddress: startAddr})
+}
 
-	out := make(chan *Instruction)
+// InstructionsContext is Instructions that takes Options, where the reader's
+// current position is at options.StartAddress, and stops and closes the
+// channel when ctx is done, so consumers can stop receiving early without
+// leaking the decoding goroutine. Check ctx.Err() once the channel is closed
+// to tell a cancelled decode from the end of the data
+func InstructionsContext(ctx context.Context, r io.ReadSeeker, options Options) <-chan InstructionResult {
 
-	go func() error {
+	out := make(chan InstructionResult)
+
+	send := func(res InstructionResult) {
+		select {
+		case out <- res:
+		case <-ctx.Done():
+		}
+	}
+
+	go func() {
+		defer close(out)
 		var instrValue uint32
-		for {
-			addr, _ := r.Seek(0, io.SeekCurrent)
+		for addr := uint64(options.StartAddress); ; addr += 4 {
+			if ctx.Err() != nil {
+				return
+			}
 
-			err := binary.Read(r, binary.LittleEndian, &instrValue)
+			err := binary.Read(r, options.byteOrder(), &instrValue)
 
 			if err == io.EOF {
-				break
+				return
 			}
 
 			if err != nil {
-				return fmt.Errorf("failed to read instruction: %v", err)
+				send(InstructionResult{
+					Address: addr,
+					Error:   fmt.Errorf("failed to read instruction: %v", err),
+				})
+				return
 			}
 
-			if startAddr > 0 {
-				addr += startAddr
-			} else {
-				addr = 0
+			i := &Instruction{}
+			if err := decodeInto(i, options, instrValue, addr); err != nil {
+				send(InstructionResult{
+					Address: addr,
+					Error:   &DecodeError{Raw: instrValue, Address: addr, Group: i.group, Stage: STAGE_DECODE, Err: err},
+				})
+				continue
 			}
 
-			i, err := decompose(instrValue, 0)
-			if err != nil {
-				return fmt.Errorf("failed to decompose instruction: 0x%08x; %v", instrValue, err)
-			}
+			send(InstructionResult{
+				Address:     addr,
+				Instruction: i,
+			})
+		}
+	}()
 
-			out <- i
+	return out
+}
+
+// disassembleWord decodes and formats the instruction word at addr
+func disassembleWord(instrValue uint32, addr uint64, options Options) Result {
+	i := &Instruction{}
+	if err := decodeInto(i, options, instrValue, addr); err != nil {
+		return Result{
+			StrRepr: unknownStrRepr(addr, instrValue, options.byteOrder(), err),
+			Error:   &DecodeError{Raw: instrValue, Address: addr, Group: i.group, Stage: STAGE_DECODE, Err: err},
 		}
+	}
 
-		close(out)
+	i.bigEndian = options.byteOrder() == binary.BigEndian
 
-		return nil
-	}()
+	instruction, err := format(i, options.DecimalImm)
+	if err != nil {
+		return Result{
+			StrRepr: unknownStrRepr(addr, instrValue, options.byteOrder(), err),
+			Error:   &DecodeError{Raw: instrValue, Address: addr, Group: i.group, Stage: STAGE_FORMAT, Err: err},
+		}
+	}
+
+	return Result{
+		StrRepr:     fmt.Sprintf("%#08x:  %s\t%s", addr, i.OpCodes(), instruction),
+		Instruction: i,
+		Error:       nil,
+	}
+}
 
-	return out, nil
+// unknownStrRepr is the Result.StrRepr of a word that failed to decode or format
+func unknownStrRepr(addr uint64, instrValue uint32, order binary.ByteOrder, err error) string {
+	if errors.Is(err, ErrInternal) {
+		return fmt.Sprintf("%#08x:  %s\t💥 ERROR 💥", addr, getOpCodeByteString(instrValue, order))
+	}
+	return fmt.Sprintf("%#08x:  %s\t<unknown>", addr, getOpCodeByteString(instrValue, order))
 }
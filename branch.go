@@ -0,0 +1,118 @@
+package arm64
+
+//---------------------------------------------
+// Control flow
+//---------------------------------------------
+
+// FlowKind is how an instruction changes the flow of control
+type FlowKind uint32
+
+const (
+	FLOW_NONE             FlowKind = iota // falls through to the next instruction
+	FLOW_BRANCH                           // B, B.cond, CBZ, TBZ, BR and BRAA
+	FLOW_CALL                             // BL, BLR and BLRAA, the return address is written to x30
+	FLOW_RETURN                           // RET, RETAA and RETAB
+	FLOW_EXCEPTION                        // SVC, HVC, SMC, BRK, HLT and DCPS1..3 take an exception
+	FLOW_EXCEPTION_RETURN                 // ERET, ERETAA, ERETAB and DRPS
+)
+
+func (k FlowKind) String() string {
+	return []string{"none", "branch", "call", "return", "exception", "exception_return"}[k]
+}
+
+// flowKinds maps the operations that change the flow of control to their kind
+var flowKinds = func() map[Operation]FlowKind {
+	m := map[Operation]FlowKind{
+		ARM64_B: FLOW_BRANCH, ARM64_BR: FLOW_BRANCH,
+		ARM64_BRAA: FLOW_BRANCH, ARM64_BRAAZ: FLOW_BRANCH, ARM64_BRAB: FLOW_BRANCH, ARM64_BRABZ: FLOW_BRANCH,
+		ARM64_CBZ: FLOW_BRANCH, ARM64_CBNZ: FLOW_BRANCH, ARM64_TBZ: FLOW_BRANCH, ARM64_TBNZ: FLOW_BRANCH,
+		ARM64_BL: FLOW_CALL, ARM64_BLR: FLOW_CALL,
+		ARM64_BLRAA: FLOW_CALL, ARM64_BLRAAZ: FLOW_CALL, ARM64_BLRAB: FLOW_CALL, ARM64_BLRABZ: FLOW_CALL,
+		ARM64_RET: FLOW_RETURN, ARM64_RETAA: FLOW_RETURN, ARM64_RETAB: FLOW_RETURN,
+		ARM64_SVC: FLOW_EXCEPTION, ARM64_HVC: FLOW_EXCEPTION, ARM64_SMC: FLOW_EXCEPTION,
+		ARM64_BRK: FLOW_EXCEPTION, ARM64_HLT: FLOW_EXCEPTION,
+		ARM64_DCPS1: FLOW_EXCEPTION, ARM64_DCPS2: FLOW_EXCEPTION, ARM64_DCPS3: FLOW_EXCEPTION,
+		ARM64_ERET: FLOW_EXCEPTION_RETURN, ARM64_ERETAA: FLOW_EXCEPTION_RETURN, ARM64_ERETAB: FLOW_EXCEPTION_RETURN,
+		ARM64_DRPS: FLOW_EXCEPTION_RETURN,
+	}
+	for op := range branchConditions {
+		m[op] = FLOW_BRANCH
+	}
+	return m
+}()
+
+// ControlFlow returns how i changes the flow of control, FLOW_NONE when it
+// falls through to the next instruction
+func (i *Instruction) ControlFlow() FlowKind {
+	return flowKinds[i.operation]
+}
+
+// IsBranch reports whether i is a branch, call or return. The exception
+// generating and exception return instructions are not branches.
+func (i *Instruction) IsBranch() bool {
+	switch i.ControlFlow() {
+	case FLOW_BRANCH, FLOW_CALL, FLOW_RETURN:
+		return true
+	}
+	return false
+}
+
+// IsCall reports whether i is a branch with link, BL, BLR or BLRAA
+func (i *Instruction) IsCall() bool {
+	return i.ControlFlow() == FLOW_CALL
+}
+
+// IsReturn reports whether i is a RET, RETAA or RETAB
+func (i *Instruction) IsReturn() bool {
+	return i.ControlFlow() == FLOW_RETURN
+}
+
+// IsConditional reports whether i is a branch that may fall through, B.cond,
+// CBZ, CBNZ, TBZ or TBNZ. B.AL and B.NV are always taken.
+func (i *Instruction) IsConditional() bool {
+	switch i.operation {
+	case ARM64_B_AL, ARM64_B_NV:
+		return false
+	case ARM64_CBZ, ARM64_CBNZ, ARM64_TBZ, ARM64_TBNZ:
+		return true
+	}
+	_, ok := branchConditions[i.operation]
+	return ok
+}
+
+// IsIndirect reports whether i is a branch, call or return to an address in a
+// register
+func (i *Instruction) IsIndirect() bool {
+	if !i.IsBranch() {
+		return false
+	}
+	_, direct := i.BranchTarget()
+	return !direct
+}
+
+// IsAuthenticated reports whether i authenticates its target with a pointer
+// authentication code, e.g. BRAA, BLRAAZ, RETAB or ERETAA
+func (i *Instruction) IsAuthenticated() bool {
+	switch i.operation {
+	case ARM64_BRAA, ARM64_BRAAZ, ARM64_BRAB, ARM64_BRABZ,
+		ARM64_BLRAA, ARM64_BLRAAZ, ARM64_BLRAB, ARM64_BLRABZ,
+		ARM64_RETAA, ARM64_RETAB, ARM64_ERETAA, ARM64_ERETAB:
+		return true
+	}
+	return false
+}
+
+// BranchTarget returns the absolute target of a B, BL, B.cond, CBZ, CBNZ, TBZ
+// or TBNZ, computed from its offset and Address(). It returns false for the
+// indirect branches and for instructions that are not branches.
+func (i *Instruction) BranchTarget() (uint64, bool) {
+	if !i.IsBranch() {
+		return 0, false
+	}
+	for _, op := range i.operands {
+		if op.OpClass == LABEL {
+			return op.Immediate, true
+		}
+	}
+	return 0, false
+}
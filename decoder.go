@@ -0,0 +1,148 @@
+package arm64
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// decoderBufferSize is the size of the window a Decoder reads from an io.ReaderAt
+const decoderBufferSize = 64 * 1024
+
+// Decoder synchronously decodes the instructions of a []byte or io.ReaderAt.
+// Unlike Disassemble it needs no goroutine, so it can be abandoned at any time,
+// and Next does not allocate.
+type Decoder struct {
+	r       io.ReaderAt
+	size    int64
+	buf     []byte // data, or the window of r starting at bufOff
+	bufOff  int64
+	off     int64
+	base    uint64
+	options Options
+	order   binary.ByteOrder
+	inst    Instruction
+	err     DecodeError
+}
+
+// NewDecoder returns a Decoder over data, where data[0] is at options.StartAddress
+func NewDecoder(data []byte, options Options) *Decoder {
+	return &Decoder{
+		buf:     data,
+		size:    int64(len(data)),
+		base:    uint64(options.StartAddress),
+		options: options,
+		order:   options.byteOrder(),
+	}
+}
+
+// NewReaderAtDecoder returns a Decoder over the first size bytes of r, where
+// offset 0 is at options.StartAddress
+func NewReaderAtDecoder(r io.ReaderAt, size int64, options Options) *Decoder {
+	return &Decoder{
+		r:       r,
+		size:    size,
+		buf:     make([]byte, 0, decoderBufferSize),
+		base:    uint64(options.StartAddress),
+		options: options,
+		order:   options.byteOrder(),
+	}
+}
+
+// Next decodes the instruction at the current address and advances past it.
+// The returned Instruction is owned by the Decoder and is only valid until the
+// next call. A word that fails to decode returns its address and a
+// *DecodeError, also owned by the Decoder, and decoding continues with the
+// following word. Next returns io.EOF once
+// all the data has been decoded.
+func (d *Decoder) Next() (*Instruction, uint64, error) {
+	addr := d.base + uint64(d.off)
+	word, err := d.word(d.off)
+	if err == io.ErrUnexpectedEOF {
+		d.off = d.size // skip the trailing partial word
+	}
+	if err != nil {
+		return nil, addr, err
+	}
+	d.off += 4
+	if err := d.decode(word, addr); err != nil {
+		return nil, addr, err
+	}
+	return &d.inst, addr, nil
+}
+
+// Seek moves the Decoder to addr, so the next call to Next decodes the
+// instruction there
+func (d *Decoder) Seek(addr uint64) error {
+	off, err := d.offset(addr)
+	if err != nil {
+		return err
+	}
+	d.off = off
+	return nil
+}
+
+// DecodeAt decodes the instruction at addr without moving the Decoder.
+// The returned Instruction is only valid until the next call to Next or DecodeAt.
+func (d *Decoder) DecodeAt(addr uint64) (*Instruction, error) {
+	off, err := d.offset(addr)
+	if err != nil {
+		return nil, err
+	}
+	word, err := d.word(off)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.decode(word, addr); err != nil {
+		return nil, err
+	}
+	return &d.inst, nil
+}
+
+// decode decodes word into the Decoder's instruction, failures are reported
+// through the Decoder's DecodeError so that they do not allocate either
+func (d *Decoder) decode(word uint32, addr uint64) error {
+	if err := decodeInto(&d.inst, d.options, word, addr); err != nil {
+		d.err = DecodeError{Raw: word, Address: addr, Group: d.inst.group, Stage: STAGE_DECODE, Err: err}
+		return &d.err
+	}
+	d.inst.bigEndian = d.order == binary.BigEndian
+	return nil
+}
+
+// Address returns the address of the instruction the next call to Next decodes
+func (d *Decoder) Address() uint64 {
+	return d.base + uint64(d.off)
+}
+
+func (d *Decoder) offset(addr uint64) (int64, error) {
+	if addr < d.base || addr-d.base > uint64(d.size) || (addr-d.base)%4 != 0 {
+		return 0, fmt.Errorf("address %#x is outside of the decoder data or misaligned", addr)
+	}
+	return int64(addr - d.base), nil
+}
+
+// word returns the instruction word at off, refilling the io.ReaderAt window if needed
+func (d *Decoder) word(off int64) (uint32, error) {
+	if off >= d.size {
+		return 0, io.EOF
+	}
+	if off+4 > d.size {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if d.r != nil && (off < d.bufOff || off+4 > d.bufOff+int64(len(d.buf))) {
+		n := int64(cap(d.buf))
+		if d.size-off < n {
+			n = d.size - off
+		}
+		d.buf = d.buf[:n]
+		read, err := d.r.ReadAt(d.buf, off)
+		if int64(read) < n && err != nil {
+			d.buf = d.buf[:0]
+			return 0, err
+		}
+		d.bufOff = off
+	}
+	off -= d.bufOff
+	return d.order.Uint32(d.buf[off : off+4]), nil
+}
@@ -45,6 +45,46 @@ func (i *Instruction) decompose_add_sub_carry() (*Instruction, error) {
 	return i, nil
 }
 
+func (i *Instruction) decompose_rotate_right_into_flags() (*Instruction, error) {
+
+	/*
+	 * RMIF <Xn>, #<shift>, #<mask>
+	 */
+
+	decode := AddSubWithCarry(i.raw)
+
+	if decode.Sf() != 1 || decode.Op() != 0 || decode.S() != 1 || ExtractBits(i.raw, 4, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = ARM64_RMIF
+	i.operands[0].OpClass = REG
+	i.operands[0].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rn()))
+	i.operands[1].OpClass = IMM32
+	i.operands[1].Immediate = uint64(ExtractBits(i.raw, 15, 6))
+	i.operands[2].OpClass = IMM32
+	i.operands[2].Immediate = uint64(ExtractBits(i.raw, 0, 4))
+	return i, nil
+}
+
+func (i *Instruction) decompose_evaluate_into_flags() (*Instruction, error) {
+
+	/*
+	 * SETF8 <Wn>
+	 * SETF16 <Wn>
+	 */
+
+	decode := AddSubWithCarry(i.raw)
+
+	var operation = [2]Operation{ARM64_SETF8, ARM64_SETF16}
+	if decode.Sf() != 0 || decode.Op() != 0 || decode.S() != 1 || ExtractBits(i.raw, 15, 6) != 0 || decode.Rd() != 0b01101 {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = operation[ExtractBits(i.raw, 14, 1)]
+	i.operands[0].OpClass = REG
+	i.operands[0].Reg[0] = reg(REGSET_ZR, REG_W_BASE, int(decode.Rn()))
+	return i, nil
+}
+
 func (i *Instruction) decompose_add_sub_extended_reg() (*Instruction, error) {
 
 	decode := AddSubExtendedReg(i.raw)
@@ -165,6 +205,39 @@ func (i *Instruction) decompose_add_sub_imm() (*Instruction, error) {
 	return i, nil
 }
 
+func (i *Instruction) decompose_min_max_imm() (*Instruction, error) {
+	/*
+	 * SMAX <Wd>, <Wn>, #<simm>
+	 * SMAX <Xd>, <Xn>, #<simm>
+	 * UMAX <Wd>, <Wn>, #<uimm>
+	 * UMAX <Xd>, <Xn>, #<uimm>
+	 * SMIN <Wd>, <Wn>, #<simm>
+	 * SMIN <Xd>, <Xn>, #<simm>
+	 * UMIN <Wd>, <Wn>, #<uimm>
+	 * UMIN <Xd>, <Xn>, #<uimm>
+	 */
+	decode := MinMaxImm(i.raw)
+
+	var operation = [4]Operation{ARM64_SMAX, ARM64_UMAX, ARM64_SMIN, ARM64_UMIN}
+	if decode.Op() != 0 || decode.S() != 0 || decode.Opc() > 3 {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = operation[decode.Opc()]
+	i.operands[0].OpClass = REG
+	i.operands[0].Reg[0] = reg(REGSET_ZR, int(regSize[decode.Sf()]), int(decode.Rd()))
+	i.operands[1].OpClass = REG
+	i.operands[1].Reg[0] = reg(REGSET_ZR, int(regSize[decode.Sf()]), int(decode.Rn()))
+	i.operands[2].OpClass = IMM32
+	if decode.Opc()&1 == 0 {
+		i.operands[2].Immediate = uint64(int64(int8(decode.Imm8())))
+		i.operands[2].SignedImm = 1
+	} else {
+		i.operands[2].Immediate = uint64(decode.Imm8())
+	}
+
+	return i, nil
+}
+
 func (i *Instruction) decompose_add_sub_imm_tags() (*Instruction, error) {
 	/*
 	 * ADDG <Xd|SP>, <Xn|SP>, #<uimm6>, #<uimm4>
@@ -744,6 +817,155 @@ func (i *Instruction) decompose_cryptographic_aes() (*Instruction, error) {
 	return i, nil
 }
 
+func (i *Instruction) decompose_cryptographic_3_register_imm2() (*Instruction, error) {
+	/* C4.1.95 Cryptographic three-register, imm2
+	 *
+	 * SM3TT1A <Vd>.4S, <Vn>.4S, <Vm>.S[<imm2>]
+	 * SM3TT1B <Vd>.4S, <Vn>.4S, <Vm>.S[<imm2>]
+	 * SM3TT2A <Vd>.4S, <Vn>.4S, <Vm>.S[<imm2>]
+	 * SM3TT2B <Vd>.4S, <Vn>.4S, <Vm>.S[<imm2>]
+	 */
+	decode := Cryptographic3RegImm2(i.raw)
+	var operation = [4]Operation{ARM64_SM3TT1A, ARM64_SM3TT1B, ARM64_SM3TT2A, ARM64_SM3TT2B}
+	i.operation = operation[decode.Opcode()]
+	i.operands[0].OpClass = REG
+	i.operands[0].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Rd()))
+	i.operands[0].ElementSize = 4
+	i.operands[0].DataSize = 4
+	i.operands[1].OpClass = REG
+	i.operands[1].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Rn()))
+	i.operands[1].ElementSize = 4
+	i.operands[1].DataSize = 4
+	i.operands[2].OpClass = REG
+	i.operands[2].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Rm()))
+	i.operands[2].ElementSize = 4
+	i.operands[2].HasScale = true
+	i.operands[2].Scale = decode.Imm2()
+
+	return i, nil
+}
+
+func (i *Instruction) decompose_cryptographic_3_register_sha512() (*Instruction, error) {
+	/* C4.1.96 Cryptographic three-register SHA 512
+	 *
+	 * SHA512H   <Qd>, <Qn>, <Vm>.2D
+	 * SHA512H2  <Qd>, <Qn>, <Vm>.2D
+	 * SHA512SU1 <Vd>.2D, <Vn>.2D, <Vm>.2D
+	 * RAX1      <Vd>.2D, <Vn>.2D, <Vm>.2D
+	 * SM3PARTW1 <Vd>.4S, <Vn>.4S, <Vm>.4S
+	 * SM3PARTW2 <Vd>.4S, <Vn>.4S, <Vm>.4S
+	 * SM4EKEY   <Vd>.4S, <Vn>.4S, <Vm>.4S
+	 */
+	decode := Cryptographic3RegSha512(i.raw)
+	var operation = [2][4]Operation{
+		{ARM64_SHA512H, ARM64_SHA512H2, ARM64_SHA512SU1, ARM64_RAX1},
+		{ARM64_SM3PARTW1, ARM64_SM3PARTW2, ARM64_SM4EKEY, ARM64_UNDEFINED},
+	}
+	i.operation = operation[decode.O()][decode.Opcode()]
+	i.operands[0].OpClass = REG
+	i.operands[1].OpClass = REG
+	i.operands[2].OpClass = REG
+	i.operands[0].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Rd()))
+	i.operands[1].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Rn()))
+	i.operands[2].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Rm()))
+	var elementSize = [2]uint32{8, 4}
+	for idx := 0; idx < 3; idx++ {
+		i.operands[idx].ElementSize = elementSize[decode.O()]
+		i.operands[idx].DataSize = 16 / elementSize[decode.O()]
+	}
+	if i.operation == ARM64_SHA512H || i.operation == ARM64_SHA512H2 {
+		i.operands[0].Reg[0] = reg(REGSET_ZR, REG_Q_BASE, int(decode.Rd()))
+		i.operands[0].ElementSize = 0
+		i.operands[0].DataSize = 0
+		i.operands[1].Reg[0] = reg(REGSET_ZR, REG_Q_BASE, int(decode.Rn()))
+		i.operands[1].ElementSize = 0
+		i.operands[1].DataSize = 0
+	}
+
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+
+	return i, nil
+}
+
+func (i *Instruction) decompose_cryptographic_4_register() (*Instruction, error) {
+	/* C4.1.94 Cryptographic four-register
+	 *
+	 * EOR3   <Vd>.16B, <Vn>.16B, <Vm>.16B, <Va>.16B
+	 * BCAX   <Vd>.16B, <Vn>.16B, <Vm>.16B, <Va>.16B
+	 * SM3SS1 <Vd>.4S, <Vn>.4S, <Vm>.4S, <Va>.4S
+	 */
+	decode := Cryptographic4Reg(i.raw)
+	var operation = [4]Operation{ARM64_EOR3, ARM64_BCAX, ARM64_SM3SS1, ARM64_UNDEFINED}
+	var elementSize = [4]uint32{1, 1, 4, 0}
+	var dataSize = [4]uint32{16, 16, 4, 0}
+	i.operation = operation[decode.Op0()]
+	i.operands[0].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Rd()))
+	i.operands[1].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Rn()))
+	i.operands[2].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Rm()))
+	i.operands[3].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Ra()))
+	for idx := 0; idx < 4; idx++ {
+		i.operands[idx].OpClass = REG
+		i.operands[idx].ElementSize = elementSize[decode.Op0()]
+		i.operands[idx].DataSize = dataSize[decode.Op0()]
+	}
+
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+
+	return i, nil
+}
+
+func (i *Instruction) decompose_cryptographic_xar() (*Instruction, error) {
+	/* C4.1.97 XAR
+	 *
+	 * XAR <Vd>.2D, <Vn>.2D, <Vm>.2D, #<imm6>
+	 */
+	decode := CryptographicXar(i.raw)
+	i.operation = ARM64_XAR
+	i.operands[0].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Rd()))
+	i.operands[1].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Rn()))
+	i.operands[2].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Rm()))
+	for idx := 0; idx < 3; idx++ {
+		i.operands[idx].OpClass = REG
+		i.operands[idx].ElementSize = 8
+		i.operands[idx].DataSize = 2
+	}
+	i.operands[3].OpClass = IMM32
+	i.operands[3].Immediate = uint64(decode.Imm6())
+
+	return i, nil
+}
+
+func (i *Instruction) decompose_cryptographic_2_register_sha512() (*Instruction, error) {
+	/* C4.1.98 Cryptographic two-register SHA 512
+	 *
+	 * SHA512SU0 <Vd>.2D, <Vn>.2D
+	 * SM4E      <Vd>.4S, <Vn>.4S
+	 */
+	decode := Cryptographic2RegSha512(i.raw)
+	var operation = [4]Operation{ARM64_SHA512SU0, ARM64_SM4E, ARM64_UNDEFINED, ARM64_UNDEFINED}
+	var elementSize = [4]uint32{8, 4, 0, 0}
+	i.operation = operation[decode.Opcode()]
+	i.operands[0].OpClass = REG
+	i.operands[0].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Rd()))
+	i.operands[1].OpClass = REG
+	i.operands[1].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(decode.Rn()))
+
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+
+	for idx := 0; idx < 2; idx++ {
+		i.operands[idx].ElementSize = elementSize[decode.Opcode()]
+		i.operands[idx].DataSize = 16 / elementSize[decode.Opcode()]
+	}
+
+	return i, nil
+}
+
 func (i *Instruction) decompose_data_processing_1() (*Instruction, error) {
 	/* C4.5.7 Data-processing (1 source)
 	 *
@@ -757,14 +979,20 @@ func (i *Instruction) decompose_data_processing_1() (*Instruction, error) {
 	 * CLZ <Xd>, <Xn>
 	 * CLS <Wd>, <Wn>
 	 * CLS <Xd>, <Xn>
+	 * CTZ <Wd>, <Wn>
+	 * CTZ <Xd>, <Xn>
+	 * CNT <Wd>, <Wn>
+	 * CNT <Xd>, <Xn>
+	 * ABS <Wd>, <Wn>
+	 * ABS <Xd>, <Xn>
 	 */
 
 	decode := DataProcessing1(i.raw)
 	pac := PointerAuth(i.raw)
 
-	var operation = [2][8]Operation{
-		{ARM64_RBIT, ARM64_REV16, ARM64_REV, ARM64_UNDEFINED, ARM64_CLZ, ARM64_CLS, ARM64_UNDEFINED, ARM64_UNDEFINED},
-		{ARM64_RBIT, ARM64_REV16, ARM64_REV32, ARM64_REV, ARM64_CLZ, ARM64_CLS, ARM64_UNDEFINED, ARM64_UNDEFINED},
+	var operation = [2][9]Operation{
+		{ARM64_RBIT, ARM64_REV16, ARM64_REV, ARM64_UNDEFINED, ARM64_CLZ, ARM64_CLS, ARM64_CTZ, ARM64_CNT, ARM64_ABS},
+		{ARM64_RBIT, ARM64_REV16, ARM64_REV32, ARM64_REV, ARM64_CLZ, ARM64_CLS, ARM64_CTZ, ARM64_CNT, ARM64_ABS},
 	}
 
 	var pacOperation = [2][8]Operation{
@@ -774,7 +1002,7 @@ func (i *Instruction) decompose_data_processing_1() (*Instruction, error) {
 
 	switch decode.Opcode2() {
 	case 0:
-		if decode.Opcode() > 5 {
+		if decode.Opcode() > 8 {
 			return i, nil
 		}
 		i.operation = operation[decode.Sf()][decode.Opcode()]
@@ -842,6 +1070,10 @@ func (i *Instruction) decompose_data_processing_2() (*Instruction, error) {
 	 * PACGA <Xd>, <Xn>, <Xm|SP>
 	 * SUBP <Xd>, <Xn|SP>, <Xm|SP>
 	 * SUBPS <Xd>, <Xn|SP>, <Xm|SP>
+	 * SMAX <Wd>, <Wn>, <Wm>
+	 * UMAX <Wd>, <Wn>, <Wm>
+	 * SMIN <Wd>, <Wn>, <Wm>
+	 * UMIN <Wd>, <Wn>, <Wm>
 	 */
 	var operation = [2][32]Operation{
 		{
@@ -851,7 +1083,7 @@ func (i *Instruction) decompose_data_processing_2() (*Instruction, error) {
 			ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
 			ARM64_CRC32B, ARM64_CRC32H, ARM64_CRC32W, ARM64_UNDEFINED,
 			ARM64_CRC32CB, ARM64_CRC32CH, ARM64_CRC32CW, ARM64_UNDEFINED,
-			ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+			ARM64_SMAX, ARM64_UMAX, ARM64_SMIN, ARM64_UMIN,
 			ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
 		}, {
 			ARM64_SUBP, ARM64_UNDEFINED, ARM64_UDIV, ARM64_SDIV,
@@ -860,7 +1092,7 @@ func (i *Instruction) decompose_data_processing_2() (*Instruction, error) {
 			ARM64_PACGA, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
 			ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_CRC32X,
 			ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_CRC32CX,
-			ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+			ARM64_SMAX, ARM64_UMAX, ARM64_SMIN, ARM64_UMIN,
 			ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
 		},
 	}
@@ -1010,13 +1242,14 @@ func (i *Instruction) decompose_exception_generation() (*Instruction, error) {
 	 * DCPS1 {#<imm>}
 	 * DCPS2 {#<imm>}
 	 * DCPS3 {#<imm>}
+	 * TCANCEL #<imm>
 	 */
 	decode := ExceptionGeneration(i.raw)
 	var operation = [8][4]Operation{
 		{ARM64_UNDEFINED, ARM64_SVC, ARM64_HVC, ARM64_SMC},
 		{ARM64_BRK, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
 		{ARM64_HLT, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
-		{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+		{ARM64_TCANCEL, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
 		{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
 		{ARM64_UNDEFINED, ARM64_DCPS1, ARM64_DCPS2, ARM64_DCPS3},
 		{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
@@ -1108,9 +1341,8 @@ func (i *Instruction) decompose_fixed_floating_conversion() (*Instruction, error
 		fallthrough
 	case ARM64_UCVTF:
 		{
-			var sdReg = [2]uint32{REG_S_BASE, REG_D_BASE}
 			var regSize = [2]uint32{REG_W_BASE, REG_X_BASE}
-			i.operands[0].Reg[0] = reg(REGSET_ZR, int(sdReg[decode.Type()&1]), int(decode.Rd()))
+			i.operands[0].Reg[0] = reg(REGSET_ZR, int(sdReg[decode.Type()]), int(decode.Rd()))
 			i.operands[1].Reg[0] = reg(REGSET_ZR, int(regSize[decode.Sf()]), int(decode.Rn()))
 		}
 		break
@@ -1126,7 +1358,7 @@ func (i *Instruction) decompose_fixed_floating_conversion() (*Instruction, error
 		break
 	}
 
-	if (decode.Sf() == 0 && (decode.Scale()>>5) == 0) || decode.Type() > 3 || decode.Opcode() > 3 {
+	if (decode.Sf() == 0 && (decode.Scale()>>5) == 0) || decode.Type() == 2 || decode.Opcode() > 3 {
 		return nil, failedToDecodeInstruction
 	}
 
@@ -1136,6 +1368,7 @@ func (i *Instruction) decompose_fixed_floating_conversion() (*Instruction, error
 func (i *Instruction) decompose_floating_compare() (*Instruction, error) {
 	/* C4.6.22 Floating-point compare
 	 *
+	 * FCMP  <Hn>, <Hm>
 	 * FCMP  <Sn>, <Sm>
 	 * FCMP  <Dn>, <Dm>
 	 * FCMPE <Sn>, <Sm>
@@ -1146,23 +1379,23 @@ func (i *Instruction) decompose_floating_compare() (*Instruction, error) {
 	 * FCMPE <Dn>, #0.0
 	 */
 	var operation = [2]Operation{ARM64_FCMP, ARM64_FCMPE}
-	var regChoice = [2]uint32{REG_S_BASE, REG_D_BASE}
+	var regChoice = [4]uint32{REG_S_BASE, REG_D_BASE, 0, REG_H_BASE}
 
 	decode := FloatingCompare(i.raw)
 
 	i.operation = operation[(decode.Opcode2()>>4)&1]
 	i.operands[0].OpClass = REG
-	i.operands[0].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rn()))
+	i.operands[0].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rn()))
 	if ((decode.Opcode2() >> 3) & 1) == 1 {
 		//zero variant
 		i.operands[1].OpClass = FIMM32
 		i.operands[1].Immediate = uint64(uint32(float64(0.0)))
 	} else {
 		i.operands[1].OpClass = REG
-		i.operands[1].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rm()))
+		i.operands[1].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rm()))
 	}
 
-	if decode.M() != 0 || decode.S() != 0 || decode.Op() != 0 || decode.Type() > 1 || decode.Opcode2()&^uint32(0x18) != 0 {
+	if decode.M() != 0 || decode.S() != 0 || decode.Op() != 0 || decode.Type() == 2 || decode.Opcode2()&^uint32(0x18) != 0 {
 		return nil, failedToDecodeInstruction
 	}
 
@@ -1172,6 +1405,7 @@ func (i *Instruction) decompose_floating_compare() (*Instruction, error) {
 func (i *Instruction) decompose_floating_conditional_compare() (*Instruction, error) {
 	/* C4.6.23 Floating-point conditional compare
 	 *
+	 * FCCMP  <Hn>, <Hm>, #<nzcv>, <cond>
 	 * FCCMP  <Sn>, <Sm>, #<nzcv>, <cond>
 	 * FCCMP  <Dn>, <Dm>, #<nzcv>, <cond>
 	 * FCCMPE <Sn>, <Sm>, #<nzcv>, <cond>
@@ -1179,19 +1413,19 @@ func (i *Instruction) decompose_floating_conditional_compare() (*Instruction, er
 	 */
 	decode := FloatingConditionalCompare(i.raw)
 	var operation = [2]Operation{ARM64_FCCMP, ARM64_FCCMPE}
-	var regChoice = [2]uint32{REG_S_BASE, REG_D_BASE}
+	var regChoice = [4]uint32{REG_S_BASE, REG_D_BASE, 0, REG_H_BASE}
 	i.operation = operation[decode.Op()]
 	i.operands[0].OpClass = REG
-	i.operands[0].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rn()))
+	i.operands[0].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rn()))
 	i.operands[1].OpClass = REG
-	i.operands[1].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rm()))
+	i.operands[1].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rm()))
 	i.operands[2].OpClass = IMM32
 	i.operands[2].Immediate = uint64(decode.Nzvb())
 
 	i.operands[3].OpClass = CONDITION
 	i.operands[3].Reg[0] = decode.Cond()
 
-	if decode.S() != 0 || decode.M() != 0 || decode.Type() > 1 {
+	if decode.S() != 0 || decode.M() != 0 || decode.Type() == 2 {
 		return nil, failedToDecodeInstruction
 	}
 
@@ -1364,23 +1598,24 @@ func (i *Instruction) decompose_simd_3_reg_ext() (*Instruction, error) {
 func (i *Instruction) decompose_floating_cselect() (*Instruction, error) {
 	/* C4.6.24 Floating-point conditional select
 	 *
+	 * FCSEL <Hd>, <Hn>, <Hm>, <cond>
 	 * FCSEL <Sd>, <Sn>, <Sm>, <cond>
 	 * FCSEL <Dd>, <Dn>, <Dm>, <cond>
 	 */
 
-	var regChoice = [2]uint32{REG_S_BASE, REG_D_BASE}
+	var regChoice = [4]uint32{REG_S_BASE, REG_D_BASE, 0, REG_H_BASE}
 	decode := FloatingConditionalSelect(i.raw)
 	i.operation = ARM64_FCSEL
 	i.operands[0].OpClass = REG
-	i.operands[0].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rd()))
+	i.operands[0].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rd()))
 	i.operands[1].OpClass = REG
-	i.operands[1].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rn()))
+	i.operands[1].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rn()))
 	i.operands[2].OpClass = REG
-	i.operands[2].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rm()))
+	i.operands[2].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rm()))
 	i.operands[3].OpClass = CONDITION
 	i.operands[3].Reg[0] = decode.Cond()
 
-	if decode.M() != 0 || decode.S() != 0 || decode.Type() > 1 {
+	if decode.M() != 0 || decode.S() != 0 || decode.Type() == 2 {
 		return nil, failedToDecodeInstruction
 	}
 
@@ -1390,6 +1625,7 @@ func (i *Instruction) decompose_floating_cselect() (*Instruction, error) {
 func (i *Instruction) decompose_floating_data_processing1() (*Instruction, error) {
 	/* C4.6.25 Floating-point data-processing (1 source)
 	 *
+	 * FMOV   <Hd>, <Hn>
 	 * FMOV   <Sd>, <Sn>
 	 * FABS   <Sd>, <Sn>
 	 * FNEG   <Sd>, <Sn>
@@ -1420,7 +1656,7 @@ func (i *Instruction) decompose_floating_data_processing1() (*Instruction, error
 	 * FCVT   <Dd>, <Sn>
 	 */
 
-	var regChoice = [2]uint32{REG_S_BASE, REG_D_BASE}
+	var regChoice = [4]uint32{REG_S_BASE, REG_D_BASE, 0, REG_H_BASE}
 
 	decode := FloatingDataProcessing1(i.raw)
 	// fmt.Println(decode)
@@ -1480,11 +1716,11 @@ func (i *Instruction) decompose_floating_data_processing1() (*Instruction, error
 		i.operands[0].Reg[0] = reg(REGSET_ZR, int(regBase0), int(decode.Rd()))
 		i.operands[1].Reg[0] = reg(REGSET_ZR, int(regBase1), int(decode.Rn()))
 	} else {
-		i.operands[0].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rd()))
-		i.operands[1].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rn()))
+		i.operands[0].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rd()))
+		i.operands[1].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rn()))
 	}
 
-	if decode.M() != 0 || decode.S() != 0 || i.operation == ARM64_UNDEFINED {
+	if decode.M() != 0 || decode.S() != 0 || decode.Type() == 2 || (decode.Type() == 3 && decode.Opcode() > 15) || i.operation == ARM64_UNDEFINED {
 		return nil, failedToDecodeInstruction
 	}
 
@@ -1494,6 +1730,7 @@ func (i *Instruction) decompose_floating_data_processing1() (*Instruction, error
 func (i *Instruction) decompose_floating_data_processing2() (*Instruction, error) {
 	/* C4.6.26  Floating-point data-processing (2 source)
 	 *
+	 * FMUL   <Hd>, <Hn>, <Hm>
 	 * FMUL   <Sd>, <Sn>, <Sm>
 	 * FDIV   <Sd>, <Sn>, <Sm>
 	 * FADD   <Sd>, <Sn>, <Sm>
@@ -1514,7 +1751,7 @@ func (i *Instruction) decompose_floating_data_processing2() (*Instruction, error
 	 * FMINNM <Dd>, <Dn>, <Dm>
 	 * FNMUL  <Dd>, <Dn>, <Dm>
 	 */
-	var regChoice = [2]uint32{REG_S_BASE, REG_D_BASE}
+	var regChoice = [4]uint32{REG_S_BASE, REG_D_BASE, 0, REG_H_BASE}
 	var operation = [16]Operation{
 		ARM64_FMUL, ARM64_FDIV, ARM64_FADD, ARM64_FSUB,
 		ARM64_FMAX, ARM64_FMIN, ARM64_FMAXNM, ARM64_FMINNM,
@@ -1526,11 +1763,11 @@ func (i *Instruction) decompose_floating_data_processing2() (*Instruction, error
 	i.operands[0].OpClass = REG
 	i.operands[1].OpClass = REG
 	i.operands[2].OpClass = REG
-	i.operands[0].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rd()))
-	i.operands[1].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rn()))
-	i.operands[2].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rm()))
+	i.operands[0].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rd()))
+	i.operands[1].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rn()))
+	i.operands[2].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rm()))
 
-	if decode.M() != 0 || decode.S() != 0 || decode.Type() > 1 || decode.Opcode() > 8 {
+	if decode.M() != 0 || decode.S() != 0 || decode.Type() == 2 || decode.Opcode() > 8 {
 		return nil, failedToDecodeInstruction
 	}
 
@@ -1540,6 +1777,7 @@ func (i *Instruction) decompose_floating_data_processing2() (*Instruction, error
 func (i *Instruction) decompose_floating_data_processing3() (*Instruction, error) {
 	/* C4.6.27 Floating-point data-processing (3 source)
 	 *
+	 * FMADD  <Hd>, <Hn>, <Hm>, <Ha>
 	 * FMADD  <Sd>, <Sn>, <Sm>, <Sa>
 	 * FMSUB  <Sd>, <Sn>, <Sm>, <Sa>
 	 * FNMADD <Sd>, <Sn>, <Sm>, <Sa>
@@ -1553,19 +1791,19 @@ func (i *Instruction) decompose_floating_data_processing3() (*Instruction, error
 		{ARM64_FMADD, ARM64_FMSUB},
 		{ARM64_FNMADD, ARM64_FNMSUB},
 	}
-	var regChoice = [2]uint32{REG_S_BASE, REG_D_BASE}
+	var regChoice = [4]uint32{REG_S_BASE, REG_D_BASE, 0, REG_H_BASE}
 	decode := FloatingDataProcessing3(i.raw)
 	i.operation = operation[decode.O1()][decode.O0()]
 	i.operands[0].OpClass = REG
 	i.operands[1].OpClass = REG
 	i.operands[2].OpClass = REG
 	i.operands[3].OpClass = REG
-	i.operands[0].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rd()))
-	i.operands[1].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rn()))
-	i.operands[2].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rm()))
-	i.operands[3].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Ra()))
+	i.operands[0].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rd()))
+	i.operands[1].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rn()))
+	i.operands[2].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rm()))
+	i.operands[3].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Ra()))
 
-	if decode.M() != 0 || decode.S() != 0 || decode.Type() > 1 {
+	if decode.M() != 0 || decode.S() != 0 || decode.Type() == 2 {
 		return nil, failedToDecodeInstruction
 	}
 
@@ -1605,18 +1843,19 @@ func vFPExpandImm(imm8 uint32) uint32 {
 func (i *Instruction) decompose_floating_imm() (*Instruction, error) {
 	/* C4.6.28 Floating-point immediate
 	 *
+	 * FMOV <Hd>, #<imm>
 	 * FMOV <Sd>, #<imm>
 	 * FMOV <Dd>, #<imm>
 	 */
-	var regChoice = [2]uint32{REG_S_BASE, REG_D_BASE}
+	var regChoice = [4]uint32{REG_S_BASE, REG_D_BASE, 0, REG_H_BASE}
 	decode := FloatingImm(i.raw)
 	i.operation = ARM64_FMOV
 	i.operands[0].OpClass = REG
 	i.operands[1].OpClass = FIMM32
-	i.operands[0].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()&1]), int(decode.Rd()))
+	i.operands[0].Reg[0] = reg(REGSET_ZR, int(regChoice[decode.Type()]), int(decode.Rd()))
 	i.operands[1].Immediate = uint64(vFPExpandImm(decode.Imm8())) // TODO: step this, it's wrong ⚠️
 
-	if decode.Imm5() != 0 || decode.Type() > 1 || decode.M() != 0 || decode.S() != 0 {
+	if decode.Imm5() != 0 || decode.Type() == 2 || decode.M() != 0 || decode.S() != 0 {
 		return nil, failedToDecodeInstruction
 	}
 
@@ -1676,6 +1915,11 @@ func (i *Instruction) decompose_floating_integer_conversion() (*Instruction, err
 	 * UCVTF  <Sd>, <Xn>
 	 * UCVTF  <Dd>, <Xn>
 	 *
+	 * FCVTZS <Wd>, <Hn>
+	 * SCVTF  <Hd>, <Wn>
+	 * FMOV   <Hd>, <Wn>
+	 * FMOV   <Wd>, <Hn>
+	 * FMOV   <Xd>, <Hn>
 	 * FMOV   <Sd>, <Wn>
 	 * FMOV   <Wd>, <Sn>
 	 * FMOV   <Xd>, <Dn>
@@ -1715,7 +1959,7 @@ func (i *Instruction) decompose_floating_integer_conversion() (*Instruction, err
 		},
 	}
 
-	var srcReg = [2]uint32{REG_S_BASE, REG_D_BASE}
+	var srcReg = [4]uint32{REG_S_BASE, REG_D_BASE, 0, REG_H_BASE}
 	var dstReg = [2]uint32{REG_W_BASE, REG_X_BASE}
 
 	decode := FloatingIntegerConversion(i.raw)
@@ -1732,14 +1976,18 @@ func (i *Instruction) decompose_floating_integer_conversion() (*Instruction, err
 		fallthrough
 	case ARM64_UCVTF:
 		{
-			var sdReg = [2]uint32{REG_S_BASE, REG_D_BASE}
 			var wxReg = [2]uint32{REG_W_BASE, REG_X_BASE}
-			i.operands[0].Reg[0] = reg(REGSET_ZR, int(sdReg[decode.Type()&1]), int(decode.Rd()))
+			i.operands[0].Reg[0] = reg(REGSET_ZR, int(srcReg[decode.Type()]), int(decode.Rd()))
 			i.operands[1].Reg[0] = reg(REGSET_ZR, int(wxReg[decode.Sf()]), int(decode.Rn()))
 		}
 		break
 	case ARM64_FMOV:
-		if decode.Sf() == 0 {
+		if decode.Type() == 3 {
+			// the half-precision moves from and to a W or X register
+			var hwReg = [2]uint32{dstReg[decode.Sf()], REG_H_BASE}
+			i.operands[0].Reg[0] = reg(REGSET_ZR, int(hwReg[decode.Opcode()&1]), int(decode.Rd()))
+			i.operands[1].Reg[0] = reg(REGSET_ZR, int(hwReg[(^decode.Opcode()&1)]), int(decode.Rn()))
+		} else if decode.Sf() == 0 {
 			var swReg = [2]uint32{REG_W_BASE, REG_S_BASE}
 			i.operands[0].Reg[0] = reg(REGSET_ZR, int(swReg[decode.Opcode()&1]), int(decode.Rd()))
 			i.operands[1].Reg[0] = reg(REGSET_ZR, int(swReg[(^decode.Opcode()&1)]), int(decode.Rn())) // TODO: is this always correct? replaced !
@@ -1762,11 +2010,11 @@ func (i *Instruction) decompose_floating_integer_conversion() (*Instruction, err
 		break
 	default:
 		i.operands[0].Reg[0] = reg(REGSET_ZR, int(dstReg[decode.Sf()]), int(decode.Rd()))
-		i.operands[1].Reg[0] = reg(REGSET_ZR, int(srcReg[decode.Type()&1]), int(decode.Rn()))
+		i.operands[1].Reg[0] = reg(REGSET_ZR, int(srcReg[decode.Type()]), int(decode.Rn()))
 		break
 	}
 
-	if decode.S() != 0 || i.operation == ARM64_UNDEFINED {
+	if decode.S() != 0 || (decode.Type() == 2 && i.operation != ARM64_FMOV) || i.operation == ARM64_UNDEFINED {
 		return nil, failedToDecodeInstruction
 	}
 
@@ -1983,6 +2231,323 @@ func (i *Instruction) decompose_load_store_mem_tags() (*Instruction, error) {
 	return i, nil
 }
 
+func (i *Instruction) decompose_load_store_memcpy_memset() (*Instruction, error) {
+
+	/* Memory Copy and Memory Set
+	 *
+	 * CPYFP<opt> [<Xd>]!, [<Xs>]!, <Xn>!
+	 * CPYFM<opt> [<Xd>]!, [<Xs>]!, <Xn>!
+	 * CPYFE<opt> [<Xd>]!, [<Xs>]!, <Xn>!
+	 * CPYP<opt> [<Xd>]!, [<Xs>]!, <Xn>!
+	 * CPYM<opt> [<Xd>]!, [<Xs>]!, <Xn>!
+	 * CPYE<opt> [<Xd>]!, [<Xs>]!, <Xn>!
+	 *
+	 * SETP<opt> [<Xd>]!, <Xn>!, <Xs>
+	 * SETM<opt> [<Xd>]!, <Xn>!, <Xs>
+	 * SETE<opt> [<Xd>]!, <Xn>!, <Xs>
+	 * SETGP<opt> [<Xd>]!, <Xn>!, <Xs>
+	 * SETGM<opt> [<Xd>]!, <Xn>!, <Xs>
+	 * SETGE<opt> [<Xd>]!, <Xn>!, <Xs>
+	 */
+
+	var cpyOperation = [2][3][16]Operation{
+		{
+			{ARM64_CPYFP, ARM64_CPYFPWT, ARM64_CPYFPRT, ARM64_CPYFPT,
+				ARM64_CPYFPWN, ARM64_CPYFPWTWN, ARM64_CPYFPRTWN, ARM64_CPYFPTWN,
+				ARM64_CPYFPRN, ARM64_CPYFPWTRN, ARM64_CPYFPRTRN, ARM64_CPYFPTRN,
+				ARM64_CPYFPN, ARM64_CPYFPWTN, ARM64_CPYFPRTN, ARM64_CPYFPTN},
+			{ARM64_CPYFM, ARM64_CPYFMWT, ARM64_CPYFMRT, ARM64_CPYFMT,
+				ARM64_CPYFMWN, ARM64_CPYFMWTWN, ARM64_CPYFMRTWN, ARM64_CPYFMTWN,
+				ARM64_CPYFMRN, ARM64_CPYFMWTRN, ARM64_CPYFMRTRN, ARM64_CPYFMTRN,
+				ARM64_CPYFMN, ARM64_CPYFMWTN, ARM64_CPYFMRTN, ARM64_CPYFMTN},
+			{ARM64_CPYFE, ARM64_CPYFEWT, ARM64_CPYFERT, ARM64_CPYFET,
+				ARM64_CPYFEWN, ARM64_CPYFEWTWN, ARM64_CPYFERTWN, ARM64_CPYFETWN,
+				ARM64_CPYFERN, ARM64_CPYFEWTRN, ARM64_CPYFERTRN, ARM64_CPYFETRN,
+				ARM64_CPYFEN, ARM64_CPYFEWTN, ARM64_CPYFERTN, ARM64_CPYFETN},
+		},
+		{
+			{ARM64_CPYP, ARM64_CPYPWT, ARM64_CPYPRT, ARM64_CPYPT,
+				ARM64_CPYPWN, ARM64_CPYPWTWN, ARM64_CPYPRTWN, ARM64_CPYPTWN,
+				ARM64_CPYPRN, ARM64_CPYPWTRN, ARM64_CPYPRTRN, ARM64_CPYPTRN,
+				ARM64_CPYPN, ARM64_CPYPWTN, ARM64_CPYPRTN, ARM64_CPYPTN},
+			{ARM64_CPYM, ARM64_CPYMWT, ARM64_CPYMRT, ARM64_CPYMT,
+				ARM64_CPYMWN, ARM64_CPYMWTWN, ARM64_CPYMRTWN, ARM64_CPYMTWN,
+				ARM64_CPYMRN, ARM64_CPYMWTRN, ARM64_CPYMRTRN, ARM64_CPYMTRN,
+				ARM64_CPYMN, ARM64_CPYMWTN, ARM64_CPYMRTN, ARM64_CPYMTN},
+			{ARM64_CPYE, ARM64_CPYEWT, ARM64_CPYERT, ARM64_CPYET,
+				ARM64_CPYEWN, ARM64_CPYEWTWN, ARM64_CPYERTWN, ARM64_CPYETWN,
+				ARM64_CPYERN, ARM64_CPYEWTRN, ARM64_CPYERTRN, ARM64_CPYETRN,
+				ARM64_CPYEN, ARM64_CPYEWTN, ARM64_CPYERTN, ARM64_CPYETN},
+		},
+	}
+	var setOperation = [2][3][4]Operation{
+		{
+			{ARM64_SETP, ARM64_SETPT, ARM64_SETPN, ARM64_SETPTN},
+			{ARM64_SETM, ARM64_SETMT, ARM64_SETMN, ARM64_SETMTN},
+			{ARM64_SETE, ARM64_SETET, ARM64_SETEN, ARM64_SETETN},
+		},
+		{
+			{ARM64_SETGP, ARM64_SETGPT, ARM64_SETGPN, ARM64_SETGPTN},
+			{ARM64_SETGM, ARM64_SETGMT, ARM64_SETGMN, ARM64_SETGMTN},
+			{ARM64_SETGE, ARM64_SETGET, ARM64_SETGEN, ARM64_SETGETN},
+		},
+	}
+
+	o0 := ExtractBits(i.raw, 26, 1)
+	op1 := ExtractBits(i.raw, 22, 2)
+	op2 := ExtractBits(i.raw, 12, 4)
+	rs := ExtractBits(i.raw, 16, 5)
+	rn := ExtractBits(i.raw, 5, 5)
+	rd := ExtractBits(i.raw, 0, 5)
+
+	if ExtractBits(i.raw, 30, 2) != 0 || rd == 31 || rd == rs || rd == rn || rs == rn {
+		return nil, failedToDecodeInstruction
+	}
+
+	i.operands[0].OpClass = MEM_REG
+	i.operands[0].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(rd))
+	i.operands[0].Writeback = true
+	i.operands[1].OpClass = REG
+	i.operands[2].OpClass = REG
+
+	if op1 == 3 {
+		/* SET* [<Xd>]!, <Xn>!, <Xs> */
+		if op2>>2 == 3 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = setOperation[o0][op2>>2][op2&3]
+		i.operands[1].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(rn))
+		i.operands[1].Writeback = true
+		i.operands[2].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(rs))
+	} else {
+		/* CPY* [<Xd>]!, [<Xs>]!, <Xn>! */
+		if rs == 31 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = cpyOperation[o0][op1][op2]
+		i.operands[1].OpClass = MEM_REG
+		i.operands[1].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(rs))
+		i.operands[1].Writeback = true
+		i.operands[2].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(rn))
+		i.operands[2].Writeback = true
+	}
+
+	return i, nil
+}
+
+func (i *Instruction) decompose_load_store_ordered() (*Instruction, error) {
+
+	/* LRCPC3 ordered loads and stores
+	 *
+	 * STILP <Wt1>, <Wt2>, [<Xn|SP>, #-8]!
+	 * STILP <Xt1>, <Xt2>, [<Xn|SP>, #-16]!
+	 * STILP <Wt1>, <Wt2>, [<Xn|SP>]
+	 * STILP <Xt1>, <Xt2>, [<Xn|SP>]
+	 * LDIAPP <Wt1>, <Wt2>, [<Xn|SP>], #8
+	 * LDIAPP <Xt1>, <Xt2>, [<Xn|SP>], #16
+	 * LDIAPP <Wt1>, <Wt2>, [<Xn|SP>]
+	 * LDIAPP <Xt1>, <Xt2>, [<Xn|SP>]
+	 * STLR <Wt>, [<Xn|SP>, #-4]!
+	 * STLR <Xt>, [<Xn|SP>, #-8]!
+	 * LDAPR <Wt>, [<Xn|SP>], #4
+	 * LDAPR <Xt>, [<Xn|SP>], #8
+	 */
+
+	var operation = [4]Operation{ARM64_STILP, ARM64_LDIAPP, ARM64_STLR, ARM64_LDAPR}
+	var regBase = [2]uint32{REG_W_BASE, REG_X_BASE}
+
+	size := ExtractBits(i.raw, 30, 2)
+	opc := ExtractBits(i.raw, 22, 2)
+	rt2 := ExtractBits(i.raw, 16, 5)
+	opc2 := ExtractBits(i.raw, 12, 4)
+	rn := ExtractBits(i.raw, 5, 5)
+	rt := ExtractBits(i.raw, 0, 5)
+
+	if size < 2 || opc2 > 1 || (opc>>1 == 1 && (opc2 != 0 || rt2 != 0)) {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = operation[opc]
+	bytes := int64(4 << (size - 2))
+
+	i.operands[0].OpClass = REG
+	i.operands[0].Reg[0] = reg(REGSET_ZR, int(regBase[size-2]), int(rt))
+	mem := 1
+	if opc>>1 == 0 {
+		i.operands[1].OpClass = REG
+		i.operands[1].Reg[0] = reg(REGSET_ZR, int(regBase[size-2]), int(rt2))
+		bytes *= 2
+		mem = 2
+	}
+	i.operands[mem].Reg[0] = reg(REGSET_SP, REG_X_BASE, int(rn))
+	if opc2 == 1 {
+		i.operands[mem].OpClass = MEM_REG
+	} else if opc&1 == 0 {
+		i.operands[mem].OpClass = MEM_PRE_IDX
+		i.operands[mem].SignedImm = 1
+		i.operands[mem].Immediate = uint64(-bytes)
+	} else {
+		i.operands[mem].OpClass = MEM_POST_IDX
+		i.operands[mem].Immediate = uint64(bytes)
+	}
+
+	return i, nil
+}
+
+func (i *Instruction) decompose_load_store_pair_atomic() (*Instruction, error) {
+
+	/* 128-bit atomic memory operations and read-check-write compare and swap
+	 *
+	 * LDCLRP{A|AL|L} <Xt1>, <Xt2>, [<Xn|SP>]
+	 * LDSETP{A|AL|L} <Xt1>, <Xt2>, [<Xn|SP>]
+	 * SWPP{A|AL|L} <Xt1>, <Xt2>, [<Xn|SP>]
+	 * RCW{S}CLRP{A|AL|L} <Xt1>, <Xt2>, [<Xn|SP>]
+	 * RCW{S}SWPP{A|AL|L} <Xt1>, <Xt2>, [<Xn|SP>]
+	 * RCW{S}SETP{A|AL|L} <Xt1>, <Xt2>, [<Xn|SP>]
+	 * RCW{S}CAS{A|AL|L} <Xs>, <Xt>, [<Xn|SP>]
+	 * RCW{S}CASP{A|AL|L} <Xs>, <X(s+1)>, <Xt>, <X(t+1)>, [<Xn|SP>]
+	 */
+
+	var operation = [2][2][8][4]Operation{
+		{
+			{
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_LDCLRP, ARM64_LDCLRPL, ARM64_LDCLRPA, ARM64_LDCLRPAL},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_LDSETP, ARM64_LDSETPL, ARM64_LDSETPA, ARM64_LDSETPAL},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+			}, {
+				{ARM64_SWPP, ARM64_SWPPL, ARM64_SWPPA, ARM64_SWPPAL},
+				{ARM64_RCWCLRP, ARM64_RCWCLRPL, ARM64_RCWCLRPA, ARM64_RCWCLRPAL},
+				{ARM64_RCWSWPP, ARM64_RCWSWPPL, ARM64_RCWSWPPA, ARM64_RCWSWPPAL},
+				{ARM64_RCWSETP, ARM64_RCWSETPL, ARM64_RCWSETPA, ARM64_RCWSETPAL},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+			},
+		}, {
+			{
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+			}, {
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_RCWSCLRP, ARM64_RCWSCLRPL, ARM64_RCWSCLRPA, ARM64_RCWSCLRPAL},
+				{ARM64_RCWSSWPP, ARM64_RCWSSWPPL, ARM64_RCWSSWPPA, ARM64_RCWSSWPPAL},
+				{ARM64_RCWSSETP, ARM64_RCWSSETPL, ARM64_RCWSSETPA, ARM64_RCWSSETPAL},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+				{ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED},
+			},
+		},
+	}
+	var casOperation = [2][2][4]Operation{
+		{{ARM64_RCWCAS, ARM64_RCWCASL, ARM64_RCWCASA, ARM64_RCWCASAL}, {ARM64_RCWCASP, ARM64_RCWCASPL, ARM64_RCWCASPA, ARM64_RCWCASPAL}},
+		{{ARM64_RCWSCAS, ARM64_RCWSCASL, ARM64_RCWSCASA, ARM64_RCWSCASAL}, {ARM64_RCWSCASP, ARM64_RCWSCASPL, ARM64_RCWSCASPA, ARM64_RCWSCASPAL}},
+	}
+
+	decode := LdstAtomic(i.raw)
+	s := ExtractBits(i.raw, 30, 1)
+	ordering := decode.A()<<1 | decode.R()
+
+	switch ExtractBits(i.raw, 10, 6) {
+	case 0b000010:
+		fallthrough
+	case 0b000011:
+		pair := ExtractBits(i.raw, 10, 1)
+		i.operation = casOperation[s][pair][ordering]
+		if pair == 1 && (decode.Rs()&1 != 0 || decode.Rt()&1 != 0) {
+			return nil, failedToDecodeInstruction
+		}
+		idx := 0
+		for _, r := range []uint32{decode.Rs(), decode.Rt()} {
+			for n := uint32(0); n <= pair; n++ {
+				i.operands[idx].OpClass = REG
+				i.operands[idx].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(r+n))
+				idx++
+			}
+		}
+		i.operands[idx].OpClass = MEM_REG
+		i.operands[idx].Reg[0] = reg(REGSET_SP, REG_X_BASE, int(decode.Rn()))
+		return i, nil
+	default:
+		if decode.O0() != 0 {
+			return nil, failedToDecodeInstruction
+		}
+	}
+
+	i.operation = operation[s][decode.O1()][decode.Opc()][ordering]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	i.operands[0].OpClass = REG
+	i.operands[0].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rt()))
+	i.operands[1].OpClass = REG
+	i.operands[1].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rs()))
+	i.operands[2].OpClass = MEM_REG
+	i.operands[2].Reg[0] = reg(REGSET_SP, REG_X_BASE, int(decode.Rn()))
+
+	return i, nil
+}
+
+func (i *Instruction) decompose_atomic_read_check_write() (*Instruction, error) {
+
+	/* Read-check-write atomic memory operations
+	 *
+	 * RCW{S}CLR{A|AL|L} <Xs>, <Xt>, [<Xn|SP>]
+	 * RCW{S}SWP{A|AL|L} <Xs>, <Xt>, [<Xn|SP>]
+	 * RCW{S}SET{A|AL|L} <Xs>, <Xt>, [<Xn|SP>]
+	 */
+
+	var operation = [2][3][4]Operation{
+		{{ARM64_RCWCLR, ARM64_RCWCLRL, ARM64_RCWCLRA, ARM64_RCWCLRAL}, {ARM64_RCWSWP, ARM64_RCWSWPL, ARM64_RCWSWPA, ARM64_RCWSWPAL}, {ARM64_RCWSET, ARM64_RCWSETL, ARM64_RCWSETA, ARM64_RCWSETAL}},
+		{{ARM64_RCWSCLR, ARM64_RCWSCLRL, ARM64_RCWSCLRA, ARM64_RCWSCLRAL}, {ARM64_RCWSSWP, ARM64_RCWSSWPL, ARM64_RCWSSWPA, ARM64_RCWSSWPAL}, {ARM64_RCWSSET, ARM64_RCWSSETL, ARM64_RCWSSETA, ARM64_RCWSSETAL}},
+	}
+
+	decode := LdstAtomic(i.raw)
+
+	i.operation = operation[decode.Size()][decode.Opc()-1][decode.A()<<1|decode.R()]
+	i.operands[0].OpClass = REG
+	i.operands[0].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rs()))
+	i.operands[1].OpClass = REG
+	i.operands[1].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rt()))
+	i.operands[2].OpClass = MEM_REG
+	i.operands[2].Reg[0] = reg(REGSET_SP, REG_X_BASE, int(decode.Rn()))
+
+	return i, nil
+}
+
+func (i *Instruction) decompose_load_store_gcs() (*Instruction, error) {
+
+	/* Guarded Control Stack store
+	 *
+	 * GCSSTR <Xt>, [<Xn|SP>]
+	 * GCSSTTR <Xt>, [<Xn|SP>]
+	 */
+
+	var operation = [2]Operation{ARM64_GCSSTR, ARM64_GCSSTTR}
+
+	if ExtractBits(i.raw, 16, 5) != 31 || ExtractBits(i.raw, 13, 3) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = operation[ExtractBits(i.raw, 12, 1)]
+	i.operands[0].OpClass = REG
+	i.operands[0].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(ExtractBits(i.raw, 0, 5)))
+	i.operands[1].OpClass = MEM_REG
+	i.operands[1].Reg[0] = reg(REGSET_SP, REG_X_BASE, int(ExtractBits(i.raw, 5, 5)))
+
+	return i, nil
+}
+
 func (i *Instruction) decompose_load_store_unscaled() (*Instruction, error) {
 
 	/*
@@ -2285,6 +2850,14 @@ func (i *Instruction) decompose_load_store_reg_imm_pre_idx() (*Instruction, erro
 
 func (i *Instruction) decompose_atomic_memory_ops() (*Instruction, error) {
 
+	if decode := LdstAtomic(i.raw); decode.V() == 0 && decode.Size() == 3 && decode.A() == 0 && decode.R() == 0 &&
+		decode.O1() == 1 && decode.Opc() != 0 && decode.Opc() != 4 {
+		return i.decompose_load_store_64bytes()
+	}
+	if decode := LdstAtomic(i.raw); decode.V() == 0 && decode.Size() < 2 && decode.O1() == 1 && decode.Opc() != 0 && decode.Opc() < 4 {
+		return i.decompose_atomic_read_check_write()
+	}
+
 	var operation = [8][4][4]Operation{
 		{
 			{ARM64_SWPB, ARM64_SWPLB, ARM64_SWPAB, ARM64_SWPALB},
@@ -2333,6 +2906,9 @@ func (i *Instruction) decompose_atomic_memory_ops() (*Instruction, error) {
 	decode := LdstAtomic(i.raw)
 	// fmt.Println(decode)
 	// fmt.Printf("Opc: %d, Size: %d, A|R: %d\n", decode.Opc(), decode.Size(), decode.A()<<1|decode.R())
+	if decode.V() != 0 {
+		return nil, failedToDecodeInstruction
+	}
 
 	i.operation = operation[decode.Opc()][decode.Size()][decode.A()<<1|decode.R()]
 	i.operands[0].OpClass = REG
@@ -2388,6 +2964,46 @@ func (i *Instruction) decompose_atomic_memory_ops() (*Instruction, error) {
 	return i, nil
 }
 
+func (i *Instruction) decompose_load_store_64bytes() (*Instruction, error) {
+
+	/* Single-copy atomic 64-byte loads and stores, transferring the eight
+	 * consecutive registers <Xt> to <Xt+7>
+	 *
+	 * LD64B <Xt>, [<Xn|SP> {,#0}]
+	 * ST64B <Xt>, [<Xn|SP> {,#0}]
+	 * ST64BV <Xs>, <Xt>, [<Xn|SP>]
+	 * ST64BV0 <Xs>, <Xt>, [<Xn|SP>]
+	 */
+
+	var operation = [8]Operation{
+		ARM64_UNDEFINED, ARM64_ST64B, ARM64_ST64BV0, ARM64_ST64BV,
+		ARM64_UNDEFINED, ARM64_LD64B, ARM64_UNDEFINED, ARM64_UNDEFINED,
+	}
+
+	decode := LdstAtomic(i.raw)
+
+	i.operation = operation[decode.Opc()]
+	if i.operation == ARM64_UNDEFINED || decode.Rt()&1 != 0 || decode.Rt() > 22 {
+		return nil, failedToDecodeInstruction
+	}
+
+	i.operands[0].OpClass = REG
+	i.operands[0].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rs()))
+	i.operands[1].OpClass = REG
+	i.operands[1].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rt()))
+	i.operands[2].OpClass = MEM_REG
+	i.operands[2].Reg[0] = reg(REGSET_SP, REG_X_BASE, int(decode.Rn()))
+
+	if i.operation == ARM64_LD64B || i.operation == ARM64_ST64B {
+		if decode.Rs() != 31 {
+			return nil, failedToDecodeInstruction
+		}
+		i.deleteOperand(0)
+	}
+
+	return i, nil
+}
+
 func (i *Instruction) decompose_load_store_pac() (*Instruction, error) {
 
 	decode := LdstRegImmPac(i.raw)
@@ -2655,6 +3271,7 @@ func (i *Instruction) decompose_load_store_reg_reg_offset() (*Instruction, error
 	 * LDRH   <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
 	 * LDRSW  <Xt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
 	 * PRFM <prfop>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	 * RPRFM (<rprfop>|#<imm6>), <Xm>, [<Xn|SP>]
 	 */
 	decode := LdstRegRegOffset(i.raw)
 	type opreg struct {
@@ -2723,6 +3340,25 @@ func (i *Instruction) decompose_load_store_reg_reg_offset() (*Instruction, error
 	if decode.Option()>>1 == 0 || decode.Option()>>1 == 2 {
 		return nil, failedToDecodeInstruction
 	}
+	if op.operation == ARM64_PRFM && decode.Rt()>>3 == 3 {
+		var rprfop = [8]Register{
+			REG_PLDKEEP, REG_PSTKEEP, REG_NONE, REG_NONE, REG_PLDSTRM, REG_PSTSTRM, REG_NONE, REG_NONE,
+		}
+		imm := (decode.Option()>>2)<<5 | (decode.Option()&1)<<4 | decode.S()<<3 | (decode.Rt() & 7)
+		i.operation = ARM64_RPRFM
+		if imm < 8 && rprfop[imm] != REG_NONE {
+			i.operands[0].OpClass = REG
+			i.operands[0].Reg[0] = uint32(rprfop[imm])
+		} else {
+			i.operands[0].OpClass = IMM32
+			i.operands[0].Immediate = uint64(imm)
+		}
+		i.operands[1].OpClass = REG
+		i.operands[1].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rm()))
+		i.operands[2].OpClass = MEM_REG
+		i.operands[2].Reg[0] = reg(REGSET_SP, REG_X_BASE, int(decode.Rn()))
+		return i, nil
+	}
 	i.operation = op.operation
 
 	i.operands[0].OpClass = REG
@@ -4564,7 +5200,7 @@ func (i *Instruction) decompose_simd_load_store_single() (*Instruction, error) {
 	default:
 		return nil, failedToDecodeInstruction
 	}
-	i.operands[1].OpClass = REG
+	i.operands[1].OpClass = MEM_REG
 	i.operands[1].Reg[0] = reg(REGSET_SP, REG_X_BASE, int(decode.Rn()))
 
 	if i.operation == ARM64_UNDEFINED {
@@ -6208,6 +6844,13 @@ func (i *Instruction) decompose_system_arch_hints(decode System) (*Instruction,
 			i.operands[0].Reg[0] = uint32(REG_CSYNC)
 			break
 
+		// Added for 9.4
+		case 19:
+			i.operation = ARM64_GCSB
+			i.operands[0].OpClass = SYS_REG
+			i.operands[0].Reg[0] = uint32(REG_DSYNC)
+			break
+
 		// Added for 8.3
 		case 7:
 			i.operation = ARM64_XPACLRI
@@ -6271,6 +6914,26 @@ func (i *Instruction) decompose_system_arch_hints(decode System) (*Instruction,
 		}
 		break
 	case 3: //Barriers and CLREX
+		if decode.Op1() == 3 && decode.Op2() == 3 { //Transactional memory
+			switch (decode.L() << 4) | decode.Crm() {
+			case 0:
+				if decode.Rt() != 31 {
+					return nil, failedToDecodeInstruction
+				}
+				i.operation = ARM64_TCOMMIT
+			case 0x10:
+				i.operation = ARM64_TSTART
+			case 0x11:
+				i.operation = ARM64_TTEST
+			default:
+				return nil, failedToDecodeInstruction
+			}
+			if decode.L() == 1 {
+				i.operands[0].OpClass = REG
+				i.operands[0].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rt()))
+			}
+			break
+		}
 		switch decode.Op2() {
 		case 2:
 			i.operation = ARM64_CLREX
@@ -6312,10 +6975,42 @@ func (i *Instruction) decompose_system_arch_hints(decode System) (*Instruction,
 		break
 	case 4: //PSTATE Access
 		switch decode.Op2() {
+		case 0:
+			if decode.Op1() != 0 || decode.Crm() != 0 || decode.Rt() != 31 {
+				return nil, failedToDecodeInstruction
+			}
+			i.operation = ARM64_CFINV
+			return i, nil
 		case 1:
+			if decode.Op1() == 0 { // XAFLAG
+				if decode.Rt() != 31 {
+					return nil, failedToDecodeInstruction
+				}
+				i.operation = ARM64_XAFLAG
+				return i, nil
+			}
 			i.operands[0].Reg[0] = uint32(REG_SSBS)
 			break
+		case 2:
+			if decode.Op1() != 0 || decode.Rt() != 31 { // AXFLAG
+				return nil, failedToDecodeInstruction
+			}
+			i.operation = ARM64_AXFLAG
+			return i, nil
 		case 3:
+			if decode.Op1() == 3 { // SMSTART/SMSTOP, aliases of MSR SVCR<SM|ZA|SMZA>, #<imm>
+				var operation = [2]Operation{ARM64_SMSTOP, ARM64_SMSTART}
+				var field = [4]SystemReg{SYSREG_NONE, REG_SVCRSM, REG_SVCRZA, SYSREG_NONE}
+				if decode.Rt() != 31 || decode.Crm()&0xe == 0 || decode.Crm()&0x8 != 0 {
+					return nil, failedToDecodeInstruction
+				}
+				i.operation = operation[decode.Crm()&1]
+				if field[decode.Crm()>>1] != SYSREG_NONE {
+					i.operands[0].OpClass = SYS_REG
+					i.operands[0].Reg[0] = uint32(field[decode.Crm()>>1])
+				}
+				return i, nil
+			}
 			i.operands[0].Reg[0] = uint32(REG_UAO)
 			break
 		case 4:
@@ -6351,6 +7046,15 @@ func (i *Instruction) decompose_system_arch_hints(decode System) (*Instruction,
 		i.operands[1].OpClass = IMM32
 		i.operands[1].Immediate = uint64(decode.Crm())
 		break
+	case 1: //System instructions with register argument
+		if decode.L() == 0 && decode.Op1() == 3 && decode.Crm() == 0 && decode.Op2() < 2 {
+			var operation = [2]Operation{ARM64_WFET, ARM64_WFIT}
+			i.operation = operation[decode.Op2()]
+			i.operands[0].OpClass = REG
+			i.operands[0].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rt()))
+			return i, nil
+		}
+		fallthrough
 	default:
 		{
 			var operation = [2]Operation{ARM64_SYS, ARM64_SYSL}
@@ -6412,6 +7116,43 @@ func (i *Instruction) decompose_system_cache_maintenance(decode System) (*Instru
 			i.operands[1].OpClass = REG
 			i.operands[1].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rt()))
 			break
+		case 7: // Guarded control stack instructions
+			var operation = [2][8]Operation{
+				{
+					ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+					ARM64_GCSPUSHX, ARM64_GCSPOPCX, ARM64_GCSPOPX, ARM64_UNDEFINED,
+				}, {
+					ARM64_GCSPUSHM, ARM64_GCSPOPM, ARM64_GCSSS1, ARM64_GCSSS2,
+					ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+				},
+			}
+			var sysl = [8]uint32{0, 1, 0, 1, 0, 0, 0, 0}
+			if (decode.Op1() != 0 && decode.Op1() != 3) || decode.L() != sysl[decode.Op2()] {
+				return nil, failedToDecodeInstruction
+			}
+			i.operation = operation[decode.Op1()&1][decode.Op2()]
+			i.operands[0].OpClass = REG
+			i.operands[0].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rt()))
+			i.operands[1].OpClass = NONE
+			if decode.Op1() == 0 {
+				if decode.Rt() != 31 {
+					return nil, failedToDecodeInstruction
+				}
+				i.operands[0].OpClass = NONE
+			} else if i.operation == ARM64_GCSPOPM && decode.Rt() == 31 {
+				i.operands[0].OpClass = NONE
+			}
+			break
+		case 2: // Branch record buffer instructions
+			if decode.Op1() != 1 || decode.L() != 0 || decode.Rt() != 31 || (decode.Op2() != 4 && decode.Op2() != 5) {
+				return nil, failedToDecodeInstruction
+			}
+			var brbop = [2]SystemReg{REG_IALL, REG_INJ}
+			i.operation = ARM64_BRB
+			i.operands[0].OpClass = SYS_REG
+			i.operands[0].Reg[0] = uint32(brbop[decode.Op2()-4])
+			i.operands[1].OpClass = NONE
+			break
 		case 4: // Data cache zero operation
 			i.operation = ARM64_DC
 			i.operands[0].OpClass = SYS_REG
@@ -6809,6 +7550,21 @@ func (i *Instruction) decompose_system_debug_and_trace_regs(decode System) (*Ins
 		}
 		break
 	case 1:
+		if decode.Crn() == 8 && decode.Op2()&3 != 3 { //Branch record buffer records
+			var brbreg = [3]SystemReg{REG_BRBINF0_EL1, REG_BRBSRC0_EL1, REG_BRBTGT0_EL1}
+			sysreg = brbreg[decode.Op2()&3] + SystemReg(((decode.Op2()>>2)<<4)|decode.Crm())
+			break
+		} else if decode.Crn() == 9 && decode.Crm() < 3 && decode.Op2() < 3 {
+			var sysregs = [3][3]SystemReg{
+				{REG_BRBCR_EL1, REG_BRBFCR_EL1, REG_BRBTS_EL1},
+				{REG_BRBINFINJ_EL1, REG_BRBSRCINJ_EL1, REG_BRBTGTINJ_EL1},
+				{REG_BRBIDR0_EL1, SYSREG_NONE, SYSREG_NONE},
+			}
+			// The unallocated slots keep the generic name below
+			if sysreg = sysregs[decode.Crm()][decode.Op2()]; sysreg != SYSREG_NONE {
+				break
+			}
+		}
 		{
 			//Switch operands depending on load vs store
 			op1 := ^(^decode.L()) & 1
@@ -6853,6 +7609,13 @@ func (i *Instruction) decompose_system_debug_and_trace_regs(decode System) (*Ins
 	case 4:
 		if decode.Crn() == 0 && decode.Crm() == 7 && decode.Op2() == 0 {
 			sysreg = REG_DBGVCR32_EL2
+		} else if decode.Crn() == 9 && decode.Crm() == 0 && decode.Op2() == 0 {
+			sysreg = REG_BRBCR_EL2
+		}
+		break
+	case 5:
+		if decode.Crn() == 9 && decode.Crm() == 0 && decode.Op2() == 0 {
+			sysreg = REG_BRBCR_EL12
 		}
 		break
 		//default:
@@ -6886,16 +7649,19 @@ func (i *Instruction) decompose_system_debug_and_trace_regs2(decode System) (*In
 				{REG_ID_ISAR0_EL1, REG_ID_ISAR1_EL1, REG_ID_ISAR2_EL1, REG_ID_ISAR3_EL1,
 					REG_ID_ISAR4_EL1, REG_ID_ISAR5_EL1, REG_ID_MMFR4_EL1, REG_ID_ISAR6_EL1},
 				{REG_MVFR0_EL1, REG_MVFR1_EL1, REG_MVFR2_EL1, SYSREG_NONE, REG_ID_PFR2_EL1, SYSREG_NONE, REG_ID_MMFR5_EL1, SYSREG_NONE},
-				{REG_ID_AA64PFR0_EL1, REG_ID_AA64PFR1_EL1, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE},
+				{REG_ID_AA64PFR0_EL1, REG_ID_AA64PFR1_EL1, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, REG_ID_AA64SMFR0_EL1, SYSREG_NONE, SYSREG_NONE},
 				{REG_ID_AA64DFR0_EL1, REG_ID_AA64DFR1_EL1, SYSREG_NONE, SYSREG_NONE, REG_ID_AA64AFR0_EL1, REG_ID_AA64AFR1_EL1, SYSREG_NONE, SYSREG_NONE},
 				{REG_ID_AA64ISAR0_EL1, REG_ID_AA64ISAR1_EL1, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE},
 				{REG_ID_AA64MMFR0_EL1, REG_ID_AA64MMFR1_EL1, REG_ID_AA64MMFR2_EL1, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE},
 			}
+			if decode.Crm() > 7 {
+				return nil, failedToDecodeInstruction
+			}
 			sysreg = sysregs[decode.Crm()][decode.Op2()]
 		} else if decode.Crm() == 0 {
 			var sysregs = [8][8]SystemReg{
 				{SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE},
-				{REG_CCSIDR_EL1, REG_CLIDR_EL1, SYSREG_NONE, SYSREG_NONE, REG_GMID_EL1, SYSREG_NONE, SYSREG_NONE, REG_AIDR_EL1},
+				{REG_CCSIDR_EL1, REG_CLIDR_EL1, SYSREG_NONE, SYSREG_NONE, REG_GMID_EL1, SYSREG_NONE, REG_SMIDR_EL1, REG_AIDR_EL1},
 				{REG_CSSELR_EL1, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE},
 				{SYSREG_NONE, REG_CTR_EL0, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, REG_DCZID_EL0},
 				{REG_VPIDR_EL2, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, SYSREG_NONE, REG_VMPIDR_EL2, SYSREG_NONE, SYSREG_NONE},
@@ -6927,6 +7693,15 @@ func (i *Instruction) decompose_system_debug_and_trace_regs2(decode System) (*In
 					sysreg = REG_GCR_EL1
 					break
 				}
+			} else if decode.Crm() == 2 {
+				switch decode.Op2() {
+				case 4:
+					sysreg = REG_SMPRI_EL1
+					break
+				case 6:
+					sysreg = REG_SMCR_EL1
+					break
+				}
 			}
 			break
 		case 5:
@@ -6939,6 +7714,8 @@ func (i *Instruction) decompose_system_debug_and_trace_regs2(decode System) (*In
 					sysreg = REG_CPACR_EL12
 					break
 				}
+			} else if decode.Crm() == 2 && decode.Op2() == 6 {
+				sysreg = REG_SMCR_EL12
 			}
 			break
 		case 4:
@@ -6957,6 +7734,15 @@ func (i *Instruction) decompose_system_debug_and_trace_regs2(decode System) (*In
 					REG_HFGRTR_EL2, REG_HFGWTR_EL2, REG_HFGITR_EL2, REG_HACR_EL2,
 				}
 				sysreg = sysregs[decode.Op2()]
+			} else if decode.Crm() == 2 {
+				switch decode.Op2() {
+				case 5:
+					sysreg = REG_SMPRIMAP_EL2
+					break
+				case 6:
+					sysreg = REG_SMCR_EL2
+					break
+				}
 			} else if decode.Crm() == 6 {
 				sysreg = REG_ICC_PMR_EL1
 			}
@@ -6983,6 +7769,11 @@ func (i *Instruction) decompose_system_debug_and_trace_regs2(decode System) (*In
 					break
 				}
 				break
+			case 2:
+				if decode.Op2() == 6 {
+					sysreg = REG_SMCR_EL3
+				}
+				break
 			case 3:
 				if decode.Op2() == 1 {
 					sysreg = REG_MDCR_EL3
@@ -7050,8 +7841,24 @@ func (i *Instruction) decompose_system_debug_and_trace_regs2(decode System) (*In
 					break
 				}
 			}
+			if decode.Crm() == 5 {
+				switch decode.Op2() {
+				case 0:
+					sysreg = REG_GCSCR_EL1
+				case 1:
+					sysreg = REG_GCSPR_EL1
+				case 2:
+					sysreg = REG_GCSCRE0_EL1
+				}
+			}
 			break
 		case 3:
+			if decode.Crm() == 5 {
+				if decode.Op2() == 1 {
+					sysreg = REG_GCSPR_EL0
+				}
+				break
+			}
 			switch decode.Op2() {
 			case 0:
 				sysreg = REG_RNDR
@@ -7181,6 +7988,8 @@ func (i *Instruction) decompose_system_debug_and_trace_regs2(decode System) (*In
 					sysreg = REG_DLR_EL0
 					break
 				}
+			} else if decode.Op2() == 2 && decode.Crm() == 2 {
+				sysreg = REG_SVCR
 			} else if decode.Op2() == 6 {
 				sysreg = REG_SSBS
 				break
@@ -7422,6 +8231,9 @@ func (i *Instruction) decompose_system_debug_and_trace_regs2(decode System) (*In
 					{REG_MAIR_EL3, REG_AMAIR_EL3, SYSREG_NONE},
 					{SYSREG_NONE, SYSREG_NONE, SYSREG_NONE},
 				}
+				if decode.Crm() < 2 || decode.Crm() > 4 {
+					return nil, failedToDecodeInstruction
+				}
 				sysreg = sysregs[decode.Op1()][decode.Crm()-2]
 			}
 			break
@@ -7784,6 +8596,15 @@ func (i *Instruction) decompose_system_debug_and_trace_regs2(decode System) (*In
 				sysreg = sysregs[decode.Crm()&3][decode.Op2()]
 				break
 			}
+			if decode.Op1() == 3 && decode.Crm() == 0 && decode.Op2() == 5 {
+				sysreg = REG_TPIDR2_EL0
+				break
+			}
+			if decode.Op1() == 0 && decode.Crm() == 0 && (decode.Op2() == 3 || decode.Op2() == 6) {
+				var rcwmask = [2]SystemReg{REG_RCWSMASK_EL1, REG_RCWMASK_EL1}
+				sysreg = rcwmask[decode.Op2()/6]
+				break
+			}
 			if decode.Op2() > 4 {
 				switch decode.Op1() {
 				case 0:
@@ -7945,6 +8766,57 @@ func (i *Instruction) decompose_system() (*Instruction, error) {
 	return nil, failedToDecodeInstruction
 }
 
+func (i *Instruction) decompose_system_pair() (*Instruction, error) {
+	/* C4.1.66 System instructions with register argument pair
+	 *
+	 * SYSP #<op1>, <Cn>, <Cm>, #<op2>{, <Xt1>, <Xt2>}
+	 * MSRR (<systemreg>|S<op0>_<op1>_<Cn>_<Cm>_<op2>), <Xt1>, <Xt2>
+	 * MRRS <Xt1>, <Xt2>, (<systemreg>|S<op0>_<op1>_<Cn>_<Cm>_<op2>)
+	 */
+	decode := System(i.raw)
+	if decode.Rt()&1 == 1 && (decode.Rt() != 31 || decode.Op0() != 1) {
+		return nil, failedToDecodeInstruction
+	}
+	if decode.Op0() == 1 {
+		if decode.L() == 1 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_SYSP
+		i.operands[0].OpClass = IMM32
+		i.operands[0].Immediate = uint64(decode.Op1())
+		i.operands[1].OpClass = SYS_REG
+		i.operands[1].Reg[0] = uint32(REG_C0) + decode.Crn()
+		i.operands[2].OpClass = SYS_REG
+		i.operands[2].Reg[0] = uint32(REG_C0) + decode.Crm()
+		i.operands[3].OpClass = IMM32
+		i.operands[3].Immediate = uint64(decode.Op2())
+		if decode.Rt() != 31 {
+			i.operands[4].OpClass = REG
+			i.operands[4].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rt()))
+			i.operands[5].OpClass = REG
+			i.operands[5].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rt()+1))
+		}
+		return i, nil
+	}
+	if decode.Op0() != 3 {
+		return nil, failedToDecodeInstruction
+	}
+	// Reuse the MRS/MSR register tables to name the 128-bit system register
+	sysreg := &Instruction{raw: i.raw}
+	if _, err := sysreg.decompose_system_debug_and_trace_regs2(decode); err != nil {
+		return nil, err
+	}
+	var operation = [2]Operation{ARM64_MSRR, ARM64_MRRS}
+	var operandSet = [2][3]uint32{{0, 1, 2}, {2, 0, 1}}
+	i.operation = operation[decode.L()]
+	i.operands[operandSet[decode.L()][0]] = sysreg.operands[decode.L()]
+	i.operands[operandSet[decode.L()][1]].OpClass = REG
+	i.operands[operandSet[decode.L()][1]].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rt()))
+	i.operands[operandSet[decode.L()][2]].OpClass = REG
+	i.operands[operandSet[decode.L()][2]].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(decode.Rt()+1))
+	return i, nil
+}
+
 func (i *Instruction) decompose_test_branch_imm() (*Instruction, error) {
 	/* C4.2.5 Test & branch (immediate)
 	 *
@@ -8059,8 +8931,14 @@ func (i *Instruction) decompose_unconditional_branch_reg() (*Instruction, error)
 }
 
 func decompose(instructionValue uint32, address uint64) (*Instruction, error) {
+	return decompose_into(&Instruction{}, instructionValue, address)
+}
 
-	instruction := &Instruction{
+// decompose_into decodes instructionValue into the caller owned instruction,
+// overwriting any previous contents
+func decompose_into(instruction *Instruction, instructionValue uint32, address uint64) (*Instruction, error) {
+
+	*instruction = Instruction{
 		raw:       instructionValue,
 		address:   address,
 		operation: ARM64_UNDEFINED,
@@ -8068,14 +8946,19 @@ func decompose(instructionValue uint32, address uint64) (*Instruction, error) {
 	// fmt.Printf("main SWITCH: %d\n", ExtractBits(instructionValue, 25, 4))
 	switch ExtractBits(instructionValue, 25, 4) {
 	case 0:
+		if ExtractBits(instructionValue, 31, 1) == 1 {
+			instruction.group = GROUP_SME
+			return instruction.decompose_sme()
+		}
 		fallthrough
 	case 1:
 		fallthrough
-	case 2:
-		fallthrough
 	case 3:
 		instruction.group = GROUP_UNALLOCATED
 		return instruction, nil
+	case 2:
+		instruction.group = GROUP_SVE
+		return instruction.decompose_sve()
 	case 8:
 		fallthrough
 	case 9:
@@ -8088,6 +8971,9 @@ func decompose(instructionValue uint32, address uint64) (*Instruction, error) {
 		case 2:
 			return instruction.decompose_add_sub_imm()
 		case 3:
+			if ExtractBits(instructionValue, 22, 1) == 1 {
+				return instruction.decompose_min_max_imm()
+			}
 			return instruction.decompose_add_sub_imm_tags()
 		case 4:
 			return instruction.decompose_logical_imm()
@@ -8127,6 +9013,8 @@ func decompose(instructionValue uint32, address uint64) (*Instruction, error) {
 				return instruction.decompose_exception_generation()
 			} else if ExtractBits(instructionValue, 22, 3) == 4 {
 				return instruction.decompose_system()
+			} else if ExtractBits(instructionValue, 22, 3) == 5 {
+				return instruction.decompose_system_pair()
 			}
 			return instruction, nil // TODO error  ?
 		case 0x6b:
@@ -8165,10 +9053,23 @@ func decompose(instructionValue uint32, address uint64) (*Instruction, error) {
 				}
 			}
 
+			if (op0&3) == 1 && (op2>>1) == 1 && ExtractBits(instructionValue, 21, 1) == 0 && op4 == 1 {
+				return instruction.decompose_load_store_memcpy_memset()
+			}
+
 			if ExtractBits(instructionValue, 24, 6) == 25 {
 				if op0 == 13 && ExtractBits(instructionValue, 21, 1) != 0 {
 					return instruction.decompose_load_store_mem_tags()
 				}
+				if (op0 == 1 || op0 == 5) && ExtractBits(instructionValue, 21, 1) != 0 {
+					return instruction.decompose_load_store_pair_atomic()
+				}
+				if ExtractBits(instructionValue, 21, 1) == 0 && op4 == 2 {
+					return instruction.decompose_load_store_ordered()
+				}
+				if op0 == 13 && ExtractBits(instructionValue, 21, 1) == 0 && op4 == 3 {
+					return instruction.decompose_load_store_gcs()
+				}
 				return instruction.decompose_load_store_unscaled()
 			}
 
@@ -8265,6 +9166,13 @@ func decompose(instructionValue uint32, address uint64) (*Instruction, error) {
 		case 0x5f:
 			return instruction.decompose_add_sub_extended_reg()
 		case 0xd0:
+			if ExtractBits(instructionValue, 10, 5) == 1 {
+				return instruction.decompose_rotate_right_into_flags()
+			} else if ExtractBits(instructionValue, 10, 4) == 2 {
+				return instruction.decompose_evaluate_into_flags()
+			} else if ExtractBits(instructionValue, 10, 6) != 0 {
+				return nil, failedToDecodeInstruction
+			}
 			return instruction.decompose_add_sub_carry()
 		case 0xd2:
 			if ExtractBits(instructionValue, 11, 1) == 1 {
@@ -8298,6 +9206,22 @@ func decompose(instructionValue uint32, address uint64) (*Instruction, error) {
 			return nil, failedToDecodeInstruction
 		}
 	case 7:
+		if ExtractBits(instructionValue, 24, 8) == 0xce {
+			instruction.group = GROUP_DATA_PROCESSING_SIMD
+			switch {
+			case ExtractBits(instructionValue, 23, 1) == 0 && ExtractBits(instructionValue, 15, 1) == 0:
+				return instruction.decompose_cryptographic_4_register()
+			case ExtractBits(instructionValue, 21, 3) == 2 && ExtractBits(instructionValue, 14, 2) == 2:
+				return instruction.decompose_cryptographic_3_register_imm2()
+			case ExtractBits(instructionValue, 21, 3) == 3 && ExtractBits(instructionValue, 15, 1) == 1 && ExtractBits(instructionValue, 12, 2) == 0:
+				return instruction.decompose_cryptographic_3_register_sha512()
+			case ExtractBits(instructionValue, 21, 3) == 4:
+				return instruction.decompose_cryptographic_xar()
+			case ExtractBits(instructionValue, 12, 12) == 0xc08:
+				return instruction.decompose_cryptographic_2_register_sha512()
+			}
+			return nil, failedToDecodeInstruction
+		}
 		switch ExtractBits(instructionValue, 24, 5) {
 		case 14:
 			if ExtractBits(instructionValue, 10, 2) == 2 && ExtractBits(instructionValue, 17, 5) == 16 {
@@ -0,0 +1,123 @@
+package arm64
+
+//---------------------------------------------
+// Apple vendor encodings
+//---------------------------------------------
+
+// decompose_vendor decodes the vendor specific encodings selected by vendor and
+// falls back to the architectural decoder for everything else
+func decompose_vendor(vendor Vendor, instructionValue uint32, address uint64) (*Instruction, error) {
+	return decompose_vendor_into(&Instruction{}, vendor, instructionValue, address)
+}
+
+// decompose_vendor_into is decompose_vendor into a caller owned instruction
+func decompose_vendor_into(instruction *Instruction, vendor Vendor, instructionValue uint32, address uint64) (*Instruction, error) {
+	if vendor == VENDOR_APPLE {
+		*instruction = Instruction{
+			raw:       instructionValue,
+			address:   address,
+			operation: ARM64_UNDEFINED,
+			group:     GROUP_APPLE,
+			vendor:    vendor,
+		}
+		if i, err := instruction.decompose_apple(); err == nil {
+			return i, nil
+		}
+	}
+	i, err := decompose_into(instruction, instructionValue, address)
+	if i != nil {
+		i.vendor = vendor
+	}
+	return i, err
+}
+
+func (i *Instruction) decompose_apple() (*Instruction, error) {
+	/* Apple implementation defined encodings, allocated from the
+	 * architecturally UNALLOCATED space at 0x0020xxxx
+	 *
+	 * AMX<op> <Xt>
+	 * AMXSET
+	 * AMXCLR
+	 * GEXIT
+	 * GENTER #<imm>
+	 * SDSB <option>
+	 */
+	switch i.raw & 0xfffffc00 {
+	case 0x00201000:
+		return i.decompose_apple_amx()
+	case 0x00201400:
+		return i.decompose_apple_gxf()
+	}
+	return nil, failedToDecodeInstruction
+}
+
+func (i *Instruction) decompose_apple_amx() (*Instruction, error) {
+	/* Apple matrix coprocessor (AMX)
+	 *
+	 * 0000 0000 0010 0000 0001 00 op(5) operand(5)
+	 *
+	 * AMX<op> <Xt>
+	 * AMXSET
+	 * AMXCLR
+	 */
+	var operation = [23]Operation{
+		ARM64_AMXLDX, ARM64_AMXLDY, ARM64_AMXSTX, ARM64_AMXSTY,
+		ARM64_AMXLDZ, ARM64_AMXSTZ, ARM64_AMXLDZI, ARM64_AMXSTZI,
+		ARM64_AMXEXTRX, ARM64_AMXEXTRY, ARM64_AMXFMA64, ARM64_AMXFMS64,
+		ARM64_AMXFMA32, ARM64_AMXFMS32, ARM64_AMXMAC16, ARM64_AMXFMA16,
+		ARM64_AMXFMS16, ARM64_AMXSET, ARM64_AMXVECINT, ARM64_AMXVECFP,
+		ARM64_AMXMATINT, ARM64_AMXMATFP, ARM64_AMXGENLUT,
+	}
+	op := ExtractBits(i.raw, 5, 5)
+	operand := ExtractBits(i.raw, 0, 5)
+	if op >= uint32(len(operation)) {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = operation[op]
+	if i.operation == ARM64_AMXSET {
+		//The operand of op 17 is an immediate selecting set or clear
+		switch operand {
+		case 0:
+		case 1:
+			i.operation = ARM64_AMXCLR
+		default:
+			return nil, failedToDecodeInstruction
+		}
+		return i, nil
+	}
+	i.operands[0].OpClass = REG
+	i.operands[0].Reg[0] = reg(REGSET_ZR, REG_X_BASE, int(operand))
+	return i, nil
+}
+
+func (i *Instruction) decompose_apple_gxf() (*Instruction, error) {
+	/* Guarded execution (GXF) and speculation barriers
+	 *
+	 * GEXIT
+	 * GENTER #<imm>
+	 * SDSB <option>
+	 */
+	switch ExtractBits(i.raw, 5, 5) {
+	case 0:
+		if ExtractBits(i.raw, 0, 5) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_GEXIT
+	case 1:
+		i.operation = ARM64_GENTER
+		i.operands[0].OpClass = IMM32
+		i.operands[0].Immediate = uint64(ExtractBits(i.raw, 0, 5))
+	case 3:
+		//SDSB takes the same option encoding as the outer shareable to full
+		//system forms of DSB
+		if ExtractBits(i.raw, 2, 3) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_SDSB
+		i.operands[0].OpClass = SYS_REG
+		i.operands[0].Reg[0] = uint32(REG_NUMBER0) + (ExtractBits(i.raw, 0, 2)<<2 | 3)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
@@ -0,0 +1,366 @@
+package arm64
+
+//---------------------------------------------
+// C4.1.x SME encodings
+//---------------------------------------------
+
+// setSmeTile sets a ZA tile operand, za<n>.<T>
+func (i *Instruction) setSmeTile(idx int, tile, esize uint32) {
+	i.operands[idx].OpClass = SME_TILE
+	i.operands[idx].Reg[0] = reg(REGSET_ZR, REG_ZA_BASE, int(tile))
+	i.operands[idx].ElementSize = esize
+}
+
+// setSmeTileSlice sets a ZA tile slice operand, za<n><h|v>.<T>[<Wv>, <imm>]
+func (i *Instruction) setSmeTileSlice(idx int, tile, esize, v, rv, offset uint32) {
+	i.setSmeTile(idx, tile, esize)
+	i.operands[idx].Slice = SLICE_HORIZONTAL
+	if v == 1 {
+		i.operands[idx].Slice = SLICE_VERTICAL
+	}
+	i.operands[idx].IndexReg = reg(REGSET_ZR, REG_W_BASE, int(12+rv))
+	i.operands[idx].Immediate = uint64(offset)
+}
+
+// setSmeArrayVector sets a ZA array vector operand, za[<Wv>, <imm>]
+func (i *Instruction) setSmeArrayVector(idx int, rv, offset uint32) {
+	i.operands[idx].OpClass = SME_TILE
+	i.operands[idx].Reg[0] = uint32(REG_ZA)
+	i.operands[idx].IndexReg = reg(REGSET_ZR, REG_W_BASE, int(12+rv))
+	i.operands[idx].Immediate = uint64(offset)
+}
+
+// smeTileSliceFields splits the combined tile number and slice offset field of
+// an SME tile slice operand for the given element size
+func smeTileSliceFields(field, esize uint32) (tile, offset uint32) {
+	switch esize {
+	case 1:
+		return 0, field & 0xf
+	case 2:
+		return field >> 3 & 1, field & 0x7
+	case 4:
+		return field >> 2 & 3, field & 0x3
+	case 8:
+		return field >> 1 & 7, field & 0x1
+	}
+	return field & 0xf, 0
+}
+
+func (i *Instruction) decompose_sme() (*Instruction, error) {
+	switch ExtractBits(i.raw, 29, 3) {
+	case 4:
+		return i.decompose_sme_fp_outer_product()
+	case 5:
+		if ExtractBits(i.raw, 23, 1) == 0 {
+			return i.decompose_sme_multi_vector_mem()
+		}
+		return i.decompose_sme_int_outer_product()
+	case 6:
+		return i.decompose_sme_move()
+	case 7:
+		return i.decompose_sme_mem()
+	}
+	return nil, failedToDecodeInstruction
+}
+
+func (i *Instruction) decompose_sme_fp_outer_product() (*Instruction, error) {
+	/* SME Floating-point Outer Product
+	 *
+	 * FMOP{A|S} <ZAda>.<T>, <Pn>/M, <Pm>/M, <Zn>.<T>, <Zm>.<T>
+	 * FMOP{A|S} <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.H, <Zm>.H
+	 * BFMOP{A|S} <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.H, <Zm>.H
+	 */
+	var operation = [3][2]Operation{
+		{ARM64_FMOPA, ARM64_FMOPS},
+		{ARM64_BFMOPA, ARM64_BFMOPS},
+		{ARM64_FMOPA, ARM64_FMOPS},
+	}
+	if ExtractBits(i.raw, 23, 1) != 1 || ExtractBits(i.raw, 3, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	sz := ExtractBits(i.raw, 22, 1)
+	esize := uint32(4)
+	zsize := uint32(4)
+	tile := ExtractBits(i.raw, 0, 3)
+	var group uint32
+	if ExtractBits(i.raw, 24, 1) == 0 {
+		if ExtractBits(i.raw, 21, 1) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		if sz == 1 {
+			esize = 8
+			zsize = 8
+		}
+	} else {
+		if sz != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		group = 1 + ExtractBits(i.raw, 21, 1)
+		zsize = 2
+	}
+	if esize == 4 {
+		if tile > 3 {
+			return nil, failedToDecodeInstruction
+		}
+	}
+	i.operation = operation[group][ExtractBits(i.raw, 4, 1)]
+	i.setSmeTile(0, tile, esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+	i.setSvePred(2, ExtractBits(i.raw, 13, 3), PRED_MERGE)
+	i.setSveZReg(3, ExtractBits(i.raw, 5, 5), zsize)
+	i.setSveZReg(4, ExtractBits(i.raw, 16, 5), zsize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sme_int_outer_product() (*Instruction, error) {
+	/* SME Integer Outer Product
+	 *
+	 * SMOP{A|S} <ZAda>.<T>, <Pn>/M, <Pm>/M, <Zn>.<Tb>, <Zm>.<Tb>
+	 * SUMOP{A|S} <ZAda>.<T>, <Pn>/M, <Pm>/M, <Zn>.<Tb>, <Zm>.<Tb>
+	 * USMOP{A|S} <ZAda>.<T>, <Pn>/M, <Pm>/M, <Zn>.<Tb>, <Zm>.<Tb>
+	 * UMOP{A|S} <ZAda>.<T>, <Pn>/M, <Pm>/M, <Zn>.<Tb>, <Zm>.<Tb>
+	 */
+	var operation = [2][2][2]Operation{
+		{{ARM64_SMOPA, ARM64_SMOPS}, {ARM64_SUMOPA, ARM64_SUMOPS}},
+		{{ARM64_USMOPA, ARM64_USMOPS}, {ARM64_UMOPA, ARM64_UMOPS}},
+	}
+	if ExtractBits(i.raw, 3, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	esize := uint32(4)
+	tile := ExtractBits(i.raw, 0, 3)
+	if ExtractBits(i.raw, 22, 1) == 1 {
+		esize = 8
+	} else if tile > 3 {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = operation[ExtractBits(i.raw, 24, 1)][ExtractBits(i.raw, 21, 1)][ExtractBits(i.raw, 4, 1)]
+	i.setSmeTile(0, tile, esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+	i.setSvePred(2, ExtractBits(i.raw, 13, 3), PRED_MERGE)
+	i.setSveZReg(3, ExtractBits(i.raw, 5, 5), esize/4)
+	i.setSveZReg(4, ExtractBits(i.raw, 16, 5), esize/4)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sme_move() (*Instruction, error) {
+	/* SME Move, Add Vector to Array and Zero
+	 *
+	 * MOV <ZAd><HV>.<T>[<Ws>, <offs>], <Pg>/M, <Zn>.<T>
+	 * MOV <Zd>.<T>, <Pg>/M, <ZAn><HV>.<T>[<Ws>, <offs>]
+	 * ADD{H|V}A <ZAda>.<T>, <Pn>/M, <Pm>/M, <Zn>.<T>
+	 * ZERO { <mask> }
+	 * ZERO { ZT0 }
+	 */
+	if ExtractBits(i.raw, 24, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	size := ExtractBits(i.raw, 22, 2)
+	switch ExtractBits(i.raw, 17, 5) {
+	case 0:
+		fallthrough
+	case 1:
+		q := ExtractBits(i.raw, 16, 1)
+		if q == 1 && size != 3 {
+			return nil, failedToDecodeInstruction
+		}
+		esize := sveElementSize(size) << q
+		v := ExtractBits(i.raw, 15, 1)
+		rv := ExtractBits(i.raw, 13, 2)
+		pg := ExtractBits(i.raw, 10, 3)
+		i.operation = ARM64_MOV
+		if ExtractBits(i.raw, 17, 1) == 0 {
+			if ExtractBits(i.raw, 4, 1) != 0 {
+				return nil, failedToDecodeInstruction
+			}
+			tile, offset := smeTileSliceFields(ExtractBits(i.raw, 0, 4), esize)
+			i.setSmeTileSlice(0, tile, esize, v, rv, offset)
+			i.setSvePred(1, pg, PRED_MERGE)
+			i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+			return i, nil
+		}
+		if ExtractBits(i.raw, 9, 1) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		tile, offset := smeTileSliceFields(ExtractBits(i.raw, 5, 4), esize)
+		i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+		i.setSvePred(1, pg, PRED_MERGE)
+		i.setSmeTileSlice(2, tile, esize, v, rv, offset)
+	case 4:
+		if ExtractBits(i.raw, 0, 17) == 0x1 && size == 1 {
+			i.operation = ARM64_ZERO
+			i.operands[0].OpClass = MULTI_REG
+			i.operands[0].Reg[0] = uint32(REG_ZT0)
+			return i, nil
+		}
+		if ExtractBits(i.raw, 8, 9) != 0 || size != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_ZERO
+		i.operands[0].OpClass = SME_TILE_LIST
+		i.operands[0].Immediate = uint64(ExtractBits(i.raw, 0, 8))
+	case 8:
+		if size < 2 {
+			return nil, failedToDecodeInstruction
+		}
+		var operation = [2]Operation{ARM64_ADDHA, ARM64_ADDVA}
+		esize := uint32(4)
+		tile := ExtractBits(i.raw, 0, 3)
+		if size == 3 {
+			esize = 8
+		}
+		if ExtractBits(i.raw, 3, 2) != 0 || (esize == 4 && tile > 3) {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = operation[ExtractBits(i.raw, 16, 1)]
+		i.setSmeTile(0, tile, esize)
+		i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+		i.setSvePred(2, ExtractBits(i.raw, 13, 3), PRED_MERGE)
+		i.setSveZReg(3, ExtractBits(i.raw, 5, 5), esize)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sme_mem() (*Instruction, error) {
+	/* SME Memory
+	 *
+	 * LD1{B|H|W|D|Q} {<ZAt><HV>.<T>[<Ws>, <offs>]}, <Pg>/Z, [<Xn|SP>{, <Xm>, LSL #<amount>}]
+	 * ST1{B|H|W|D|Q} {<ZAt><HV>.<T>[<Ws>, <offs>]}, <Pg>, [<Xn|SP>{, <Xm>, LSL #<amount>}]
+	 * LDR ZA[<Wv>, <offs>], [<Xn|SP>{, #<offs>, MUL VL}]
+	 * STR ZA[<Wv>, <offs>], [<Xn|SP>{, #<offs>, MUL VL}]
+	 * LDR ZT0, [<Xn|SP>]
+	 * STR ZT0, [<Xn|SP>]
+	 */
+	var ld1 = [5][2]Operation{
+		{ARM64_LD1B, ARM64_ST1B},
+		{ARM64_LD1H, ARM64_ST1H},
+		{ARM64_LD1W, ARM64_ST1W},
+		{ARM64_LD1D, ARM64_ST1D},
+		{ARM64_LD1Q, ARM64_ST1Q},
+	}
+	var ldr = [2]Operation{ARM64_LDR, ARM64_STR}
+	msz := ExtractBits(i.raw, 22, 2)
+	store := ExtractBits(i.raw, 21, 1)
+	rn := ExtractBits(i.raw, 5, 5)
+	rm := ExtractBits(i.raw, 16, 5)
+	if ExtractBits(i.raw, 24, 1) == 1 {
+		switch msz {
+		case 0:
+			if rm == 0 && ExtractBits(i.raw, 15, 1) == 0 && ExtractBits(i.raw, 10, 3) == 0 && ExtractBits(i.raw, 4, 1) == 0 {
+				offset := ExtractBits(i.raw, 0, 4)
+				i.operation = ldr[store]
+				i.setSmeArrayVector(0, ExtractBits(i.raw, 13, 2), offset)
+				i.setSveMemImm(1, rn, int64(offset), true)
+				return i, nil
+			}
+			if rm == 31 && ExtractBits(i.raw, 10, 6) == 0x20 && ExtractBits(i.raw, 0, 5) == 0 {
+				i.operation = ldr[store]
+				i.operands[0].OpClass = REG
+				i.operands[0].Reg[0] = uint32(REG_ZT0)
+				i.operands[1].OpClass = MEM_REG
+				i.operands[1].Reg[0] = reg(REGSET_SP, REG_X_BASE, int(rn))
+				return i, nil
+			}
+			return nil, failedToDecodeInstruction
+		case 3:
+			msz = 4
+		default:
+			return nil, failedToDecodeInstruction
+		}
+	}
+	if ExtractBits(i.raw, 4, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	esize := uint32(1) << msz
+	tile, offset := smeTileSliceFields(ExtractBits(i.raw, 0, 4), esize)
+	i.operation = ld1[msz][store]
+	i.setSmeTileSlice(0, tile, esize, ExtractBits(i.raw, 15, 1), ExtractBits(i.raw, 13, 2), offset)
+	i.operands[0].OpClass = MULTI_REG
+	if store == 0 {
+		i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_ZERO)
+	} else {
+		i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_NONE)
+	}
+	if rm == 31 {
+		i.setSveMemImm(2, rn, 0, false)
+	} else {
+		i.setSveMemScalar(2, rn, rm, msz)
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sme_multi_vector_mem() (*Instruction, error) {
+	/* SME2 Multi-vector Contiguous Load and Store
+	 *
+	 * LD1<sz> {<Zt1>.<T>-<Zt4>.<T>}, <PNg>/Z, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	 * LD1<sz> {<Zt1>.<T>-<Zt4>.<T>}, <PNg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * LDNT1<sz> {<Zt1>.<T>-<Zt4>.<T>}, <PNg>/Z, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	 * LDNT1<sz> {<Zt1>.<T>-<Zt4>.<T>}, <PNg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * ST1<sz> {<Zt1>.<T>-<Zt4>.<T>}, <PNg>, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	 * ST1<sz> {<Zt1>.<T>-<Zt4>.<T>}, <PNg>, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * STNT1<sz> {<Zt1>.<T>-<Zt4>.<T>}, <PNg>, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	 * STNT1<sz> {<Zt1>.<T>-<Zt4>.<T>}, <PNg>, [<Xn|SP>{, #<imm>, MUL VL}]
+	 */
+	var operation = [2][2][4]Operation{
+		{
+			{ARM64_LD1B, ARM64_LD1H, ARM64_LD1W, ARM64_LD1D},
+			{ARM64_LDNT1B, ARM64_LDNT1H, ARM64_LDNT1W, ARM64_LDNT1D},
+		}, {
+			{ARM64_ST1B, ARM64_ST1H, ARM64_ST1W, ARM64_ST1D},
+			{ARM64_STNT1B, ARM64_STNT1H, ARM64_STNT1W, ARM64_STNT1D},
+		},
+	}
+	msz := ExtractBits(i.raw, 13, 2)
+	store := ExtractBits(i.raw, 21, 1)
+	rn := ExtractBits(i.raw, 5, 5)
+	rm := ExtractBits(i.raw, 16, 5)
+	count := uint32(2)
+	if ExtractBits(i.raw, 15, 1) == 1 {
+		count = 4
+	}
+	if count == 4 && ExtractBits(i.raw, 24, 1) == 0 && ExtractBits(i.raw, 1, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	i.operands[0].OpClass = MULTI_REG
+	i.operands[0].ElementSize = 1 << msz
+	if ExtractBits(i.raw, 24, 1) == 0 {
+		i.operation = operation[store][ExtractBits(i.raw, 0, 1)][msz]
+		zt := ExtractBits(i.raw, 0, 5) &^ (count - 1)
+		for n := uint32(0); n < count; n++ {
+			i.operands[0].Reg[n] = reg(REGSET_ZR, REG_Z_BASE, int(zt+n))
+		}
+	} else {
+		// strided registers, Zt is T:'0':Zt<2:0> for pairs and T:'00':Zt<1:0> for quads
+		i.operation = operation[store][ExtractBits(i.raw, 3, 1)][msz]
+		zt := ExtractBits(i.raw, 4, 1)<<4 | ExtractBits(i.raw, 0, 3)
+		if count == 4 {
+			if ExtractBits(i.raw, 2, 1) != 0 {
+				return nil, failedToDecodeInstruction
+			}
+			zt &^= 4
+		}
+		stride := 16 / count
+		for n := uint32(0); n < count; n++ {
+			i.operands[0].Reg[n] = reg(REGSET_ZR, REG_Z_BASE, int(zt+n*stride))
+		}
+	}
+	i.setSvePnReg(1, 8+ExtractBits(i.raw, 10, 3), 0)
+	if store == 0 {
+		i.operands[1].PredQual = PRED_ZERO
+	}
+	if ExtractBits(i.raw, 22, 1) == 1 {
+		if ExtractBits(i.raw, 20, 1) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		imm4 := int64(int32(ExtractBits(i.raw, 16, 4)<<28) >> 28)
+		i.setSveMemImm(2, rn, imm4*int64(count), true)
+		return i, nil
+	}
+	if rm == 31 {
+		return nil, failedToDecodeInstruction
+	}
+	i.setSveMemScalar(2, rn, rm, msz)
+	return i, nil
+}
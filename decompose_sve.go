@@ -0,0 +1,3097 @@
+package arm64
+
+//---------------------------------------------
+// C4.1.x SVE encodings
+//---------------------------------------------
+
+// sveElementSize maps the two bit SVE size field to an element size in bytes
+func sveElementSize(size uint32) uint32 {
+	return 1 << size
+}
+
+// sveTszElementSize decodes the element size from a tsz field, returning 0 when reserved
+func sveTszElementSize(tsz uint32) uint32 {
+	switch {
+	case tsz&0x8 != 0:
+		return 8
+	case tsz&0x4 != 0:
+		return 4
+	case tsz&0x2 != 0:
+		return 2
+	case tsz&0x1 != 0:
+		return 1
+	}
+	return 0
+}
+
+func (i *Instruction) setSveZReg(idx int, r, esize uint32) {
+	i.operands[idx].OpClass = REG
+	i.operands[idx].Reg[0] = reg(REGSET_ZR, REG_Z_BASE, int(r))
+	i.operands[idx].ElementSize = esize
+}
+
+func (i *Instruction) setSveZRegIndexed(idx int, r, esize, index uint32) {
+	i.setSveZReg(idx, r, esize)
+	i.operands[idx].HasScale = true
+	i.operands[idx].Scale = index
+}
+
+func (i *Instruction) setSveZList(idx int, r, count, esize uint32) {
+	i.operands[idx].OpClass = MULTI_REG
+	for n := uint32(0); n < count; n++ {
+		i.operands[idx].Reg[n] = reg(REGSET_ZR, REG_Z_BASE, int((r+n)%32))
+	}
+	i.operands[idx].ElementSize = esize
+}
+
+// setSveVReg sets a full 128-bit SIMD&FP vector operand with the given element size
+func (i *Instruction) setSveVReg(idx int, r, esize uint32) {
+	i.operands[idx].OpClass = REG
+	i.operands[idx].Reg[0] = reg(REGSET_ZR, REG_V_BASE, int(r))
+	i.operands[idx].ElementSize = esize
+	i.operands[idx].DataSize = 16 / esize
+}
+
+func (i *Instruction) setSvePReg(idx int, r, esize uint32) {
+	i.operands[idx].OpClass = REG
+	i.operands[idx].Reg[0] = reg(REGSET_ZR, REG_P_BASE, int(r))
+	i.operands[idx].ElementSize = esize
+}
+
+// setSvePList sets a {<Pd1>.<T>, <Pd2>.<T>} predicate register list
+func (i *Instruction) setSvePList(idx int, r, count, esize uint32) {
+	i.operands[idx].OpClass = MULTI_REG
+	for n := uint32(0); n < count; n++ {
+		i.operands[idx].Reg[n] = reg(REGSET_ZR, REG_P_BASE, int((r+n)%16))
+	}
+	i.operands[idx].ElementSize = esize
+}
+
+func (i *Instruction) setSvePnReg(idx int, r, esize uint32) {
+	i.operands[idx].OpClass = REG
+	i.operands[idx].Reg[0] = reg(REGSET_ZR, REG_PN_BASE, int(r))
+	i.operands[idx].ElementSize = esize
+}
+
+func (i *Instruction) setSvePnRegIndexed(idx int, r, index uint32) {
+	i.setSvePnReg(idx, r, 0)
+	i.operands[idx].HasScale = true
+	i.operands[idx].Scale = index
+}
+
+func (i *Instruction) setSvePred(idx int, r uint32, qual PredicateQualifier) {
+	i.operands[idx].OpClass = REG
+	i.operands[idx].Reg[0] = reg(REGSET_ZR, REG_P_BASE, int(r))
+	i.operands[idx].PredQual = qual
+}
+
+func (i *Instruction) setSveGpReg(idx int, sf, r uint32, regset int) {
+	i.operands[idx].OpClass = REG
+	i.operands[idx].Reg[0] = reg(regset, int(regSize[sf]), int(r))
+}
+
+// setSveFpReg sets a scalar SIMD&FP register operand sized by a two bit SVE size field
+func (i *Instruction) setSveFpReg(idx int, size, r uint32) {
+	var regBase = [4]int{REG_B_BASE, REG_H_BASE, REG_S_BASE, REG_D_BASE}
+	i.operands[idx].OpClass = REG
+	i.operands[idx].Reg[0] = reg(REGSET_ZR, regBase[size], int(r))
+}
+
+func (i *Instruction) setSveImm(idx int, imm int64) {
+	i.operands[idx].OpClass = IMM32
+	i.operands[idx].Immediate = uint64(imm)
+	if imm < 0 {
+		i.operands[idx].SignedImm = 1
+	}
+}
+
+func (i *Instruction) setSveShiftedImm(idx int, imm int64, shift uint32) {
+	i.setSveImm(idx, imm)
+	if shift != 0 {
+		i.operands[idx].ShiftType = SHIFT_LSL
+		i.operands[idx].ShiftValueUsed = true
+		i.operands[idx].ShiftValue = shift
+	}
+}
+
+func (i *Instruction) setSvePattern(idx int, pattern, multiplier uint32) {
+	i.operands[idx].OpClass = SVE_PATTERN
+	i.operands[idx].Reg[0] = pattern
+	i.operands[idx].Immediate = uint64(multiplier)
+}
+
+// setSveMemImm sets a [<Xn|SP>{, #<imm>, MUL VL}] memory operand
+func (i *Instruction) setSveMemImm(idx int, rn uint32, imm int64, mulVl bool) {
+	i.operands[idx].OpClass = MEM_OFFSET
+	i.operands[idx].Reg[0] = reg(REGSET_SP, REG_X_BASE, int(rn))
+	i.operands[idx].Immediate = uint64(imm)
+	if imm < 0 {
+		i.operands[idx].SignedImm = 1
+	}
+	i.operands[idx].MulVl = mulVl
+}
+
+// setSveMemScalar sets a [<Xn|SP>, <Xm>{, LSL #<amount>}] memory operand
+func (i *Instruction) setSveMemScalar(idx int, rn, rm, shift uint32) {
+	i.operands[idx].OpClass = MEM_EXTENDED
+	i.operands[idx].Reg[0] = reg(REGSET_SP, REG_X_BASE, int(rn))
+	i.operands[idx].Reg[1] = reg(REGSET_ZR, REG_X_BASE, int(rm))
+	if shift != 0 {
+		i.operands[idx].ShiftType = SHIFT_LSL
+		i.operands[idx].ShiftValueUsed = true
+		i.operands[idx].ShiftValue = shift
+	}
+}
+
+// setSveMemVector sets a [<Xn|SP>, <Zm>.<T>{, <mod> #<amount>}] memory operand
+func (i *Instruction) setSveMemVector(idx int, rn, zm, esize uint32, mod ShiftType, shift uint32) {
+	i.operands[idx].OpClass = MEM_EXTENDED
+	i.operands[idx].Reg[0] = reg(REGSET_SP, REG_X_BASE, int(rn))
+	i.operands[idx].Reg[1] = reg(REGSET_ZR, REG_Z_BASE, int(zm))
+	i.operands[idx].ElementSize = esize
+	i.operands[idx].ShiftType = mod
+	if shift != 0 {
+		i.operands[idx].ShiftValueUsed = true
+		i.operands[idx].ShiftValue = shift
+	}
+}
+
+// setSveMemVectorImm sets a [<Zn>.<T>{, #<imm>}] memory operand
+func (i *Instruction) setSveMemVectorImm(idx int, zn, esize uint32, imm uint64) {
+	i.operands[idx].OpClass = MEM_OFFSET
+	i.operands[idx].Reg[0] = reg(REGSET_ZR, REG_Z_BASE, int(zn))
+	i.operands[idx].ElementSize = esize
+	i.operands[idx].Immediate = imm
+}
+
+// setSveMemVectorScalar sets a [<Zn>.<T>{, <Xm>}] memory operand
+func (i *Instruction) setSveMemVectorScalar(idx int, zn, rm, esize uint32) {
+	if rm == 31 {
+		i.setSveMemVectorImm(idx, zn, esize, 0)
+		return
+	}
+	i.operands[idx].OpClass = MEM_EXTENDED
+	i.operands[idx].Reg[0] = reg(REGSET_ZR, REG_Z_BASE, int(zn))
+	i.operands[idx].Reg[1] = reg(REGSET_ZR, REG_X_BASE, int(rm))
+	i.operands[idx].ElementSize = esize
+}
+
+func (i *Instruction) decompose_sve() (*Instruction, error) {
+	switch ExtractBits(i.raw, 29, 3) {
+	case 0:
+		if ExtractBits(i.raw, 24, 1) == 0 {
+			return i.decompose_sve_int_unpred_pred()
+		}
+		return i.decompose_sve_permute_logical_imm()
+	case 1:
+		return i.decompose_sve_compare_predicate()
+	case 2:
+		return i.decompose_sve_int_multiply_add()
+	case 3:
+		return i.decompose_sve_floating_point()
+	case 4:
+		return i.decompose_sve_mem_gather32()
+	case 5:
+		return i.decompose_sve_mem_contiguous_load()
+	case 6:
+		return i.decompose_sve_mem_gather64()
+	case 7:
+		return i.decompose_sve_mem_store()
+	}
+	return nil, failedToDecodeInstruction
+}
+
+func (i *Instruction) decompose_sve_int_unpred_pred() (*Instruction, error) {
+	if ExtractBits(i.raw, 21, 1) == 0 {
+		switch ExtractBits(i.raw, 13, 3) {
+		case 0:
+			return i.decompose_sve_int_bin_pred()
+		case 1:
+			return i.decompose_sve_int_reduce()
+		case 2:
+			fallthrough
+		case 3:
+			fallthrough
+		case 6:
+			fallthrough
+		case 7:
+			return i.decompose_sve_int_mladd_pred()
+		case 4:
+			return i.decompose_sve_int_shift_pred()
+		case 5:
+			return i.decompose_sve_int_unary_pred()
+		}
+		return nil, failedToDecodeInstruction
+	}
+	switch ExtractBits(i.raw, 12, 4) {
+	case 0:
+		fallthrough
+	case 1:
+		return i.decompose_sve_int_add_sub_unpred()
+	case 2:
+		fallthrough
+	case 3:
+		return i.decompose_sve_int_bitwise_unpred()
+	case 4:
+		return i.decompose_sve_index()
+	case 5:
+		return i.decompose_sve_stack_alloc()
+	case 6:
+		fallthrough
+	case 7:
+		return i.decompose_sve2_int_mul_unpred()
+	case 8:
+		fallthrough
+	case 9:
+		return i.decompose_sve_int_shift_unpred()
+	case 10:
+		return i.decompose_sve_adr()
+	case 11:
+		return i.decompose_sve_int_misc_unpred()
+	case 12:
+		fallthrough
+	case 13:
+		fallthrough
+	case 14:
+		fallthrough
+	case 15:
+		return i.decompose_sve_element_count()
+	}
+	return nil, failedToDecodeInstruction
+}
+
+func (i *Instruction) decompose_sve_int_bin_pred() (*Instruction, error) {
+	/* SVE Integer Binary Arithmetic - Predicated
+	 *
+	 * <op> <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, <Zm>.<T>
+	 */
+	var operation = [32]Operation{
+		ARM64_ADD, ARM64_SUB, ARM64_UNDEFINED, ARM64_SUBR,
+		ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+		ARM64_SMAX, ARM64_UMAX, ARM64_SMIN, ARM64_UMIN,
+		ARM64_SABD, ARM64_UABD, ARM64_UNDEFINED, ARM64_UNDEFINED,
+		ARM64_MUL, ARM64_UNDEFINED, ARM64_SMULH, ARM64_UMULH,
+		ARM64_SDIV, ARM64_UDIV, ARM64_SDIVR, ARM64_UDIVR,
+		ARM64_ORR, ARM64_EOR, ARM64_AND, ARM64_BIC,
+		ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+	}
+	opc := ExtractBits(i.raw, 16, 5)
+	size := ExtractBits(i.raw, 22, 2)
+	i.operation = operation[opc]
+	if i.operation == ARM64_UNDEFINED || (opc >= 0x14 && opc <= 0x17 && size < 2) {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(size)
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+	i.setSveZReg(2, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(3, ExtractBits(i.raw, 5, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_int_reduce() (*Instruction, error) {
+	/* SVE Integer Reduction
+	 *
+	 * <op> <V><d>, <Pg>, <Zn>.<T>
+	 * <op> <Vd>.<T>, <Pg>, <Zn>.<Tb>
+	 * MOVPRFX <Zd>.<T>, <Pg>/<ZM>, <Zn>.<T>
+	 */
+	var operation = [32]Operation{
+		ARM64_SADDV, ARM64_UADDV, ARM64_UNDEFINED, ARM64_UNDEFINED,
+		ARM64_UNDEFINED, ARM64_ADDQV, ARM64_UNDEFINED, ARM64_UNDEFINED,
+		ARM64_SMAXV, ARM64_UMAXV, ARM64_SMINV, ARM64_UMINV,
+		ARM64_SMAXQV, ARM64_UMAXQV, ARM64_SMINQV, ARM64_UMINQV,
+		ARM64_MOVPRFX, ARM64_MOVPRFX, ARM64_UNDEFINED, ARM64_UNDEFINED,
+		ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+		ARM64_ORV, ARM64_EORV, ARM64_ANDV, ARM64_UNDEFINED,
+		ARM64_ORQV, ARM64_EORQV, ARM64_ANDQV, ARM64_UNDEFINED,
+	}
+	opc := ExtractBits(i.raw, 16, 5)
+	size := ExtractBits(i.raw, 22, 2)
+	i.operation = operation[opc]
+	if i.operation == ARM64_UNDEFINED || (i.operation == ARM64_SADDV && size == 3) {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(size)
+	pg := ExtractBits(i.raw, 10, 3)
+	switch i.operation {
+	case ARM64_MOVPRFX:
+		var qual = [2]PredicateQualifier{PRED_ZERO, PRED_MERGE}
+		i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+		i.setSvePred(1, pg, qual[ExtractBits(i.raw, 16, 1)])
+	case ARM64_SADDV:
+		fallthrough
+	case ARM64_UADDV:
+		i.setSveFpReg(0, 3, ExtractBits(i.raw, 0, 5))
+		i.setSvePred(1, pg, PRED_NONE)
+	default:
+		if opc&0x4 != 0 {
+			i.setSveVReg(0, ExtractBits(i.raw, 0, 5), esize)
+			i.setSvePred(1, pg, PRED_NONE)
+			break
+		}
+		i.setSveFpReg(0, size, ExtractBits(i.raw, 0, 5))
+		i.setSvePred(1, pg, PRED_NONE)
+	}
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_int_mladd_pred() (*Instruction, error) {
+	/* SVE Integer Multiply-Add - Predicated
+	 *
+	 * MLA <Zda>.<T>, <Pg>/M, <Zn>.<T>, <Zm>.<T>
+	 * MLS <Zda>.<T>, <Pg>/M, <Zn>.<T>, <Zm>.<T>
+	 * MAD <Zdn>.<T>, <Pg>/M, <Zm>.<T>, <Za>.<T>
+	 * MSB <Zdn>.<T>, <Pg>/M, <Zm>.<T>, <Za>.<T>
+	 */
+	var operation = [2][2]Operation{
+		{ARM64_MLA, ARM64_MLS},
+		{ARM64_MAD, ARM64_MSB},
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	i.operation = operation[ExtractBits(i.raw, 15, 1)][ExtractBits(i.raw, 13, 1)]
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+	if ExtractBits(i.raw, 15, 1) == 0 {
+		i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+		i.setSveZReg(3, ExtractBits(i.raw, 16, 5), esize)
+	} else {
+		i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+		i.setSveZReg(3, ExtractBits(i.raw, 5, 5), esize)
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_int_shift_pred() (*Instruction, error) {
+	/* SVE Bitwise Shift - Predicated
+	 *
+	 * <op> <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, #<const>
+	 * <op> <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, <Zm>.<T>
+	 * <op> <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, <Zm>.D
+	 */
+	var immOperation = [16]Operation{
+		ARM64_ASR, ARM64_LSR, ARM64_UNDEFINED, ARM64_LSL,
+		ARM64_ASRD, ARM64_UNDEFINED, ARM64_SQSHL, ARM64_UQSHL,
+		ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+		ARM64_SRSHR, ARM64_URSHR, ARM64_UNDEFINED, ARM64_SQSHLU,
+	}
+	var vecOperation = [2][8]Operation{
+		{
+			ARM64_ASR, ARM64_LSR, ARM64_UNDEFINED, ARM64_LSL,
+			ARM64_ASRR, ARM64_LSRR, ARM64_UNDEFINED, ARM64_LSLR,
+		}, {
+			ARM64_ASR, ARM64_LSR, ARM64_UNDEFINED, ARM64_LSL,
+			ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+		},
+	}
+	size := ExtractBits(i.raw, 22, 2)
+	rdn := ExtractBits(i.raw, 0, 5)
+	pg := ExtractBits(i.raw, 10, 3)
+	if ExtractBits(i.raw, 20, 1) == 0 {
+		opc := ExtractBits(i.raw, 16, 4)
+		i.operation = immOperation[opc]
+		tsz := size<<2 | ExtractBits(i.raw, 8, 2)
+		esize := sveTszElementSize(tsz)
+		if i.operation == ARM64_UNDEFINED || esize == 0 {
+			return nil, failedToDecodeInstruction
+		}
+		imm := tsz<<3 | ExtractBits(i.raw, 5, 3)
+		i.setSveZReg(0, rdn, esize)
+		i.setSvePred(1, pg, PRED_MERGE)
+		i.setSveZReg(2, rdn, esize)
+		switch i.operation {
+		case ARM64_SQSHL:
+			fallthrough
+		case ARM64_UQSHL:
+			fallthrough
+		case ARM64_SQSHLU:
+			fallthrough
+		case ARM64_LSL:
+			i.setSveImm(3, int64(imm-esize*8))
+		default:
+			i.setSveImm(3, int64(2*esize*8-imm))
+		}
+		return i, nil
+	}
+	wide := ExtractBits(i.raw, 19, 1)
+	i.operation = vecOperation[wide][ExtractBits(i.raw, 16, 3)]
+	if i.operation == ARM64_UNDEFINED || (wide == 1 && size == 3) {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(size)
+	i.setSveZReg(0, rdn, esize)
+	i.setSvePred(1, pg, PRED_MERGE)
+	i.setSveZReg(2, rdn, esize)
+	if wide == 1 {
+		i.setSveZReg(3, ExtractBits(i.raw, 5, 5), 8)
+	} else {
+		i.setSveZReg(3, ExtractBits(i.raw, 5, 5), esize)
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_int_unary_pred() (*Instruction, error) {
+	/* SVE Integer Unary Arithmetic - Predicated
+	 *
+	 * <op> <Zd>.<T>, <Pg>/M, <Zn>.<T>
+	 */
+	var operation = [2][8]Operation{
+		{
+			ARM64_SXTB, ARM64_UXTB, ARM64_SXTH, ARM64_UXTH,
+			ARM64_SXTW, ARM64_UXTW, ARM64_ABS, ARM64_NEG,
+		}, {
+			ARM64_CLS, ARM64_CLZ, ARM64_CNT, ARM64_CNOT,
+			ARM64_FABS, ARM64_FNEG, ARM64_NOT, ARM64_UNDEFINED,
+		},
+	}
+	// minimum element size encoding for each operation
+	var minSize = [2][8]uint32{
+		{1, 1, 2, 2, 3, 3, 0, 0},
+		{0, 0, 0, 0, 1, 1, 0, 0},
+	}
+	if ExtractBits(i.raw, 20, 1) == 0 {
+		return nil, failedToDecodeInstruction
+	}
+	size := ExtractBits(i.raw, 22, 2)
+	op := ExtractBits(i.raw, 19, 1)
+	opc := ExtractBits(i.raw, 16, 3)
+	i.operation = operation[op][opc]
+	if i.operation == ARM64_UNDEFINED || size < minSize[op][opc] {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(size)
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_int_add_sub_unpred() (*Instruction, error) {
+	/* SVE Integer Arithmetic - Unpredicated
+	 *
+	 * <op> <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	 */
+	var operation = [8]Operation{
+		ARM64_ADD, ARM64_SUB, ARM64_UNDEFINED, ARM64_UNDEFINED,
+		ARM64_SQADD, ARM64_UQADD, ARM64_SQSUB, ARM64_UQSUB,
+	}
+	if ExtractBits(i.raw, 13, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = operation[ExtractBits(i.raw, 10, 3)]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_int_bitwise_unpred() (*Instruction, error) {
+	/* SVE Bitwise Logical - Unpredicated
+	 *
+	 * <op> <Zd>.D, <Zn>.D, <Zm>.D
+	 * MOV <Zd>.D, <Zn>.D
+	 */
+	var operation = [4]Operation{ARM64_AND, ARM64_ORR, ARM64_EOR, ARM64_BIC}
+	switch ExtractBits(i.raw, 10, 3) {
+	case 4:
+	case 5:
+		return i.decompose_sve2_xar()
+	case 6:
+		fallthrough
+	case 7:
+		return i.decompose_sve2_bitwise_ternary()
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	rn := ExtractBits(i.raw, 5, 5)
+	rm := ExtractBits(i.raw, 16, 5)
+	i.operation = operation[ExtractBits(i.raw, 22, 2)]
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), 8)
+	i.setSveZReg(1, rn, 8)
+	if i.operation == ARM64_ORR && rn == rm {
+		i.operation = ARM64_MOV
+		return i, nil
+	}
+	i.setSveZReg(2, rm, 8)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_index() (*Instruction, error) {
+	/* SVE Index Generation
+	 *
+	 * INDEX <Zd>.<T>, #<imm1>, #<imm2>
+	 * INDEX <Zd>.<T>, <R><n>, #<imm>
+	 * INDEX <Zd>.<T>, #<imm>, <R><m>
+	 * INDEX <Zd>.<T>, <R><n>, <R><m>
+	 */
+	if ExtractBits(i.raw, 12, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	size := ExtractBits(i.raw, 22, 2)
+	var sf uint32
+	if size == 3 {
+		sf = 1
+	}
+	i.operation = ARM64_INDEX
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), sveElementSize(size))
+	if ExtractBits(i.raw, 10, 1) == 0 {
+		i.setSveImm(1, int64(int32(signExtend(ExtractBits(i.raw, 5, 5), 5))))
+	} else {
+		i.setSveGpReg(1, sf, ExtractBits(i.raw, 5, 5), REGSET_ZR)
+	}
+	if ExtractBits(i.raw, 11, 1) == 0 {
+		i.setSveImm(2, int64(int32(signExtend(ExtractBits(i.raw, 16, 5), 5))))
+	} else {
+		i.setSveGpReg(2, sf, ExtractBits(i.raw, 16, 5), REGSET_ZR)
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_stack_alloc() (*Instruction, error) {
+	/* SVE Stack Allocation
+	 *
+	 * ADDVL <Xd|SP>, <Xn|SP>, #<imm>
+	 * ADDPL <Xd|SP>, <Xn|SP>, #<imm>
+	 * RDVL <Xd>, #<imm>
+	 * ADDSVL <Xd|SP>, <Xn|SP>, #<imm>
+	 * ADDSPL <Xd|SP>, <Xn|SP>, #<imm>
+	 * RDSVL <Xd>, #<imm>
+	 */
+	var operation = [2][3]Operation{
+		{ARM64_ADDVL, ARM64_ADDPL, ARM64_RDVL},
+		{ARM64_ADDSVL, ARM64_ADDSPL, ARM64_RDSVL},
+	}
+	streaming := ExtractBits(i.raw, 11, 1)
+	imm := int64(int32(signExtend(ExtractBits(i.raw, 5, 6), 6)))
+	switch ExtractBits(i.raw, 22, 2) {
+	case 0:
+		i.operation = operation[streaming][0]
+	case 1:
+		i.operation = operation[streaming][1]
+	case 2:
+		if ExtractBits(i.raw, 16, 5) != 0x1f {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = operation[streaming][2]
+		i.setSveGpReg(0, 1, ExtractBits(i.raw, 0, 5), REGSET_ZR)
+		i.setSveImm(1, imm)
+		return i, nil
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	i.setSveGpReg(0, 1, ExtractBits(i.raw, 0, 5), REGSET_SP)
+	i.setSveGpReg(1, 1, ExtractBits(i.raw, 16, 5), REGSET_SP)
+	i.setSveImm(2, imm)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_int_shift_unpred() (*Instruction, error) {
+	/* SVE Bitwise Shift - Unpredicated
+	 *
+	 * <op> <Zd>.<T>, <Zn>.<T>, <Zm>.D
+	 * <op> <Zd>.<T>, <Zn>.<T>, #<const>
+	 */
+	var operation = [4]Operation{ARM64_ASR, ARM64_LSR, ARM64_UNDEFINED, ARM64_LSL}
+	i.operation = operation[ExtractBits(i.raw, 10, 2)]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	size := ExtractBits(i.raw, 22, 2)
+	if ExtractBits(i.raw, 12, 1) == 0 {
+		if size == 3 {
+			return nil, failedToDecodeInstruction
+		}
+		esize := sveElementSize(size)
+		i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+		i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+		i.setSveZReg(2, ExtractBits(i.raw, 16, 5), 8)
+		return i, nil
+	}
+	tsz := size<<2 | ExtractBits(i.raw, 19, 2)
+	esize := sveTszElementSize(tsz)
+	if esize == 0 {
+		return nil, failedToDecodeInstruction
+	}
+	imm := tsz<<3 | ExtractBits(i.raw, 16, 3)
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	if i.operation == ARM64_LSL {
+		i.setSveImm(2, int64(imm-esize*8))
+	} else {
+		i.setSveImm(2, int64(2*esize*8-imm))
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_adr() (*Instruction, error) {
+	/* SVE Address Generation
+	 *
+	 * ADR <Zd>.D, [<Zn>.D, <Zm>.D, SXTW{ <amount>}]
+	 * ADR <Zd>.D, [<Zn>.D, <Zm>.D, UXTW{ <amount>}]
+	 * ADR <Zd>.<T>, [<Zn>.<T>, <Zm>.<T>{, <mod> <amount>}]
+	 */
+	var esizeMap = [4]uint32{8, 8, 4, 8}
+	var modMap = [4]ShiftType{SHIFT_SXTW, SHIFT_UXTW, SHIFT_LSL, SHIFT_LSL}
+	opc := ExtractBits(i.raw, 22, 2)
+	msz := ExtractBits(i.raw, 10, 2)
+	esize := esizeMap[opc]
+	i.operation = ARM64_ADR
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.operands[1].OpClass = MEM_EXTENDED
+	i.operands[1].Reg[0] = reg(REGSET_ZR, REG_Z_BASE, int(ExtractBits(i.raw, 5, 5)))
+	i.operands[1].Reg[1] = reg(REGSET_ZR, REG_Z_BASE, int(ExtractBits(i.raw, 16, 5)))
+	i.operands[1].ElementSize = esize
+	if opc < 2 || msz != 0 {
+		i.operands[1].ShiftType = modMap[opc]
+	}
+	if msz != 0 {
+		i.operands[1].ShiftValueUsed = true
+		i.operands[1].ShiftValue = msz
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_int_misc_unpred() (*Instruction, error) {
+	/* SVE Integer Misc - Unpredicated
+	 *
+	 * FTSSEL <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	 * FEXPA <Zd>.<T>, <Zn>.<T>
+	 * MOVPRFX <Zd>, <Zn>
+	 */
+	size := ExtractBits(i.raw, 22, 2)
+	esize := sveElementSize(size)
+	switch ExtractBits(i.raw, 10, 2) {
+	case 0:
+		if size == 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_FTSSEL
+		i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+		i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+		i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+	case 2:
+		if size == 0 || ExtractBits(i.raw, 16, 5) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_FEXPA
+		i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+		i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	case 3:
+		if size != 0 || ExtractBits(i.raw, 16, 5) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_MOVPRFX
+		i.setSveZReg(0, ExtractBits(i.raw, 0, 5), 0)
+		i.setSveZReg(1, ExtractBits(i.raw, 5, 5), 0)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+// setSveCountPattern sets the optional {<pattern>{, MUL #<imm>}} operand
+func (i *Instruction) setSveCountPattern(idx int, pattern, multiplier uint32) {
+	if pattern == uint32(SVE_ALL) && multiplier == 1 {
+		return
+	}
+	i.setSvePattern(idx, pattern, multiplier)
+}
+
+func (i *Instruction) decompose_sve_element_count() (*Instruction, error) {
+	/* SVE Element Count
+	 *
+	 * CNT{B|H|W|D} <Xd>{, <pattern>{, MUL #<imm>}}
+	 * {INC|DEC}{B|H|W|D} <Xdn>{, <pattern>{, MUL #<imm>}}
+	 * {INC|DEC}{H|W|D} <Zdn>.<T>{, <pattern>{, MUL #<imm>}}
+	 * {SQ|UQ}{INC|DEC}{B|H|W|D} <Xdn>{, <Wdn>}{, <pattern>{, MUL #<imm>}}
+	 * {SQ|UQ}{INC|DEC}{H|W|D} <Zdn>.<T>{, <pattern>{, MUL #<imm>}}
+	 */
+	var cntOperation = [4]Operation{ARM64_CNTB, ARM64_CNTH, ARM64_CNTW, ARM64_CNTD}
+	var incDecOperation = [2][4]Operation{
+		{ARM64_INCB, ARM64_INCH, ARM64_INCW, ARM64_INCD},
+		{ARM64_DECB, ARM64_DECH, ARM64_DECW, ARM64_DECD},
+	}
+	var satOperation = [4][4]Operation{
+		{ARM64_SQINCB, ARM64_SQINCH, ARM64_SQINCW, ARM64_SQINCD},
+		{ARM64_UQINCB, ARM64_UQINCH, ARM64_UQINCW, ARM64_UQINCD},
+		{ARM64_SQDECB, ARM64_SQDECH, ARM64_SQDECW, ARM64_SQDECD},
+		{ARM64_UQDECB, ARM64_UQDECH, ARM64_UQDECW, ARM64_UQDECD},
+	}
+	size := ExtractBits(i.raw, 22, 2)
+	rd := ExtractBits(i.raw, 0, 5)
+	pattern := ExtractBits(i.raw, 5, 5)
+	multiplier := ExtractBits(i.raw, 16, 4) + 1
+	op := ExtractBits(i.raw, 10, 6)
+	if ExtractBits(i.raw, 20, 1) == 0 {
+		switch op >> 2 {
+		case 0xe:
+			if op&3 != 0 {
+				return nil, failedToDecodeInstruction
+			}
+			i.operation = cntOperation[size]
+			i.setSveGpReg(0, 1, rd, REGSET_ZR)
+			i.setSveCountPattern(1, pattern, multiplier)
+		case 0xc:
+			if size == 0 {
+				return nil, failedToDecodeInstruction
+			}
+			i.operation = satOperation[op&3][size]
+			i.setSveZReg(0, rd, sveElementSize(size))
+			i.setSveCountPattern(1, pattern, multiplier)
+		case 0xf:
+			i.operation = satOperation[op&3][size]
+			if op&1 == 0 {
+				i.setSveGpReg(0, 1, rd, REGSET_ZR)
+				i.setSveGpReg(1, 0, rd, REGSET_ZR)
+				i.setSveCountPattern(2, pattern, multiplier)
+			} else {
+				i.setSveGpReg(0, 0, rd, REGSET_ZR)
+				i.setSveCountPattern(1, pattern, multiplier)
+			}
+		default:
+			return nil, failedToDecodeInstruction
+		}
+		return i, nil
+	}
+	switch op >> 1 {
+	case 0x1c:
+		i.operation = incDecOperation[op&1][size]
+		i.setSveGpReg(0, 1, rd, REGSET_ZR)
+		i.setSveCountPattern(1, pattern, multiplier)
+	case 0x18:
+		if size == 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = incDecOperation[op&1][size]
+		i.setSveZReg(0, rd, sveElementSize(size))
+		i.setSveCountPattern(1, pattern, multiplier)
+	case 0x1e:
+		fallthrough
+	case 0x1f:
+		i.operation = satOperation[op&3][size]
+		i.setSveGpReg(0, 1, rd, REGSET_ZR)
+		i.setSveCountPattern(1, pattern, multiplier)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_permute_logical_imm() (*Instruction, error) {
+	if ExtractBits(i.raw, 21, 1) == 0 {
+		switch ExtractBits(i.raw, 18, 4) {
+		case 0:
+			return i.decompose_sve_bitwise_imm()
+		case 4:
+			fallthrough
+		case 5:
+			fallthrough
+		case 6:
+			fallthrough
+		case 7:
+			return i.decompose_sve_int_wide_imm_pred()
+		}
+		return nil, failedToDecodeInstruction
+	}
+	switch ExtractBits(i.raw, 13, 3) {
+	case 0:
+		return i.decompose_sve_extract()
+	case 1:
+		return i.decompose_sve_permute_vector_unpred()
+	case 2:
+		return i.decompose_sve_permute_predicate()
+	case 3:
+		return i.decompose_sve_permute_vector_interleave()
+	case 4:
+		fallthrough
+	case 5:
+		return i.decompose_sve_permute_vector_pred()
+	case 6:
+		fallthrough
+	case 7:
+		return i.decompose_sve_select()
+	}
+	return nil, failedToDecodeInstruction
+}
+
+// sveCpyImm reports whether imm can be encoded as an 8-bit signed immediate
+// optionally shifted by 8 (see DUP/CPY immediate)
+func sveCpyImm(imm int64, esize uint32) bool {
+	isImm8 := int64(int8(imm)) == imm
+	isImm16 := int64(int16(imm&^0xff)) == imm
+	switch esize {
+	case 1:
+		return isImm8 || int64(uint8(imm)) == imm
+	case 2:
+		return isImm8 || isImm16 || int64(uint16(imm&^0xff)) == imm
+	}
+	return isImm8 || isImm16
+}
+
+// sveMoveMaskPreferred reports whether a DUPM immediate is disassembled as MOV
+func sveMoveMaskPreferred(imm uint64) bool {
+	if sveCpyImm(int64(imm), 8) {
+		return false
+	}
+	if imm == (imm&0xffffffff)*0x100000001 && sveCpyImm(int64(int32(imm)), 4) {
+		return false
+	}
+	if imm == (imm&0xffff)*0x1000100010001 && sveCpyImm(int64(int16(imm)), 2) {
+		return false
+	}
+	if imm == (imm&0xff)*0x101010101010101 && sveCpyImm(int64(int8(imm)), 1) {
+		return false
+	}
+	return true
+}
+
+func (i *Instruction) decompose_sve_bitwise_imm() (*Instruction, error) {
+	/* SVE Bitwise Immediate
+	 *
+	 * ORR <Zdn>.<T>, <Zdn>.<T>, #<const>
+	 * EOR <Zdn>.<T>, <Zdn>.<T>, #<const>
+	 * AND <Zdn>.<T>, <Zdn>.<T>, #<const>
+	 * DUPM <Zd>.<T>, #<const>
+	 */
+	var operation = [4]Operation{ARM64_ORR, ARM64_EOR, ARM64_AND, ARM64_DUPM}
+	n := ExtractBits(i.raw, 17, 1)
+	immr := ExtractBits(i.raw, 11, 6)
+	imms := ExtractBits(i.raw, 5, 6)
+	imm := DecodeBitMasks(n, imms, immr, 64)
+	if imm == 0 {
+		return nil, failedToDecodeInstruction
+	}
+	var esize uint32 = 8
+	if n == 0 {
+		switch {
+		case imms&0x20 == 0:
+			esize = 4
+		case imms&0x10 == 0:
+			esize = 2
+		default:
+			esize = 1
+		}
+	}
+	i.operation = operation[ExtractBits(i.raw, 22, 2)]
+	rd := ExtractBits(i.raw, 0, 5)
+	i.setSveZReg(0, rd, esize)
+	idx := 1
+	if i.operation == ARM64_DUPM {
+		if sveMoveMaskPreferred(imm) {
+			i.operation = ARM64_MOV
+		}
+	} else {
+		i.setSveZReg(1, rd, esize)
+		idx = 2
+	}
+	if esize != 8 {
+		imm &= uint64(1)<<(esize*8) - 1
+	}
+	if i.operation == ARM64_MOV {
+		// prefer the decimal form for values representable in 16 bits
+		simm := int64(imm<<(64-esize*8)) >> (64 - esize*8)
+		if int64(int16(simm)) == simm {
+			i.setSveImm(idx, simm)
+			return i, nil
+		}
+	}
+	i.operands[idx].OpClass = IMM64
+	i.operands[idx].Immediate = imm
+	return i, nil
+}
+
+// setSveImm8OptLsl sets a #<imm>{, <shift>} operand, folding the shift into the value
+func (i *Instruction) setSveImm8OptLsl(idx int, imm8, sh uint32, signed bool) {
+	if imm8 == 0 && sh != 0 {
+		i.setSveShiftedImm(idx, 0, 8)
+		return
+	}
+	var imm int64
+	if signed {
+		imm = int64(int8(imm8))
+	} else {
+		imm = int64(imm8)
+	}
+	i.setSveImm(idx, imm<<(sh*8))
+}
+
+func (i *Instruction) decompose_sve_int_wide_imm_pred() (*Instruction, error) {
+	/* SVE Integer Wide Immediate - Predicated
+	 *
+	 * CPY <Zd>.<T>, <Pg>/<ZM>, #<imm>{, <shift>}
+	 * FCPY <Zd>.<T>, <Pg>/M, #<const>
+	 */
+	var qual = [2]PredicateQualifier{PRED_ZERO, PRED_MERGE}
+	size := ExtractBits(i.raw, 22, 2)
+	esize := sveElementSize(size)
+	rd := ExtractBits(i.raw, 0, 5)
+	pg := ExtractBits(i.raw, 16, 4)
+	imm8 := ExtractBits(i.raw, 5, 8)
+	if ExtractBits(i.raw, 15, 1) == 0 {
+		sh := ExtractBits(i.raw, 13, 1)
+		if size == 0 && sh == 1 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_MOV
+		i.setSveZReg(0, rd, esize)
+		i.setSvePred(1, pg, qual[ExtractBits(i.raw, 14, 1)])
+		i.setSveImm8OptLsl(2, imm8, sh, true)
+		return i, nil
+	}
+	if ExtractBits(i.raw, 13, 2) != 2 || size == 0 {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = ARM64_FMOV
+	i.setSveZReg(0, rd, esize)
+	i.setSvePred(1, pg, PRED_MERGE)
+	i.operands[2].OpClass = FIMM32
+	i.operands[2].Immediate = uint64(vFPExpandImm(imm8))
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_extract() (*Instruction, error) {
+	/* SVE Permute Vector - Extract
+	 *
+	 * EXT <Zdn>.B, <Zdn>.B, <Zm>.B, #<imm>
+	 * EXT <Zd>.B, {<Zn1>.B, <Zn2>.B}, #<imm>
+	 * {ZIP1|ZIP2|UZP1|UZP2|TRN1|TRN2} <Zd>.Q, <Zn>.Q, <Zm>.Q
+	 */
+	var operation = [8]Operation{
+		ARM64_ZIP1, ARM64_ZIP2, ARM64_UZP1, ARM64_UZP2,
+		ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_TRN1, ARM64_TRN2,
+	}
+	imm := ExtractBits(i.raw, 16, 5)<<3 | ExtractBits(i.raw, 10, 3)
+	switch ExtractBits(i.raw, 22, 2) {
+	case 0:
+		i.operation = ARM64_EXT
+		rdn := ExtractBits(i.raw, 0, 5)
+		i.setSveZReg(0, rdn, 1)
+		i.setSveZReg(1, rdn, 1)
+		i.setSveZReg(2, ExtractBits(i.raw, 5, 5), 1)
+		i.setSveImm(3, int64(imm))
+		return i, nil
+	case 1:
+		i.operation = ARM64_EXT
+		i.setSveZReg(0, ExtractBits(i.raw, 0, 5), 1)
+		i.setSveZList(1, ExtractBits(i.raw, 5, 5), 2, 1)
+		i.setSveImm(2, int64(imm))
+		return i, nil
+	case 2:
+		i.operation = operation[ExtractBits(i.raw, 10, 3)]
+		if i.operation == ARM64_UNDEFINED || ExtractBits(i.raw, 21, 1) == 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.setSveZReg(0, ExtractBits(i.raw, 0, 5), 16)
+		i.setSveZReg(1, ExtractBits(i.raw, 5, 5), 16)
+		i.setSveZReg(2, ExtractBits(i.raw, 16, 5), 16)
+		return i, nil
+	}
+	return nil, failedToDecodeInstruction
+}
+
+func (i *Instruction) decompose_sve_permute_vector_unpred() (*Instruction, error) {
+	/* SVE Permute Vector - Unpredicated
+	 *
+	 * DUP <Zd>.<T>, <Zn>.<T>[<imm>]
+	 * TBL <Zd>.<T>, {<Zn>.<T>}, <Zm>.<T>
+	 * TBL <Zd>.<T>, {<Zn1>.<T>, <Zn2>.<T>}, <Zm>.<T>
+	 * {TBX|TBXQ} <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	 * DUPQ <Zd>.<T>, <Zn>.<T>[<imm>]
+	 * EXTQ <Zdn>.B, <Zdn>.B, <Zm>.B, #<imm>
+	 * PMOV <Pd>.<T>, <Zn>{[<imm>]}
+	 * PMOV <Zd>{[<imm>]}, <Pn>.<T>
+	 * DUP <Zd>.<T>, <R><n|SP>
+	 * INSR <Zdn>.<T>, <R><m>
+	 * INSR <Zdn>.<T>, <V><m>
+	 * REV <Zd>.<T>, <Zn>.<T>
+	 * {S|U}UNPK{LO|HI} <Zd>.<T>, <Zn>.<Tb>
+	 */
+	size := ExtractBits(i.raw, 22, 2)
+	esize := sveElementSize(size)
+	rd := ExtractBits(i.raw, 0, 5)
+	rn := ExtractBits(i.raw, 5, 5)
+	var sf uint32
+	if size == 3 {
+		sf = 1
+	}
+	switch ExtractBits(i.raw, 10, 3) {
+	case 0:
+		tsz := ExtractBits(i.raw, 16, 5)
+		imm := size<<5 | tsz
+		var dupSize uint32
+		switch {
+		case tsz&0x1 != 0:
+			dupSize = 0
+		case tsz&0x2 != 0:
+			dupSize = 1
+		case tsz&0x4 != 0:
+			dupSize = 2
+		case tsz&0x8 != 0:
+			dupSize = 3
+		case tsz&0x10 != 0:
+			dupSize = 4
+		default:
+			return nil, failedToDecodeInstruction
+		}
+		index := imm >> (dupSize + 1)
+		i.operation = ARM64_MOV
+		i.setSveZReg(0, rd, 1<<dupSize)
+		if index == 0 {
+			var regBase = [5]int{REG_B_BASE, REG_H_BASE, REG_S_BASE, REG_D_BASE, REG_Q_BASE}
+			i.operands[1].OpClass = REG
+			i.operands[1].Reg[0] = reg(REGSET_ZR, regBase[dupSize], int(rn))
+		} else {
+			i.setSveZRegIndexed(1, rn, 1<<dupSize, index)
+		}
+		return i, nil
+	case 1:
+		switch size {
+		case 0:
+			tsz := ExtractBits(i.raw, 16, 5)
+			dupSize := uint32(0)
+			for dupSize < 4 && tsz&(1<<dupSize) == 0 {
+				dupSize++
+			}
+			if dupSize == 4 {
+				return nil, failedToDecodeInstruction
+			}
+			i.operation = ARM64_DUPQ
+			i.setSveZReg(0, rd, 1<<dupSize)
+			i.setSveZRegIndexed(1, rn, 1<<dupSize, tsz>>(dupSize+1))
+		case 1:
+			if ExtractBits(i.raw, 20, 1) != 0 {
+				return nil, failedToDecodeInstruction
+			}
+			i.operation = ARM64_EXTQ
+			i.setSveZReg(0, rd, 1)
+			i.setSveZReg(1, rd, 1)
+			i.setSveZReg(2, rn, 1)
+			i.setSveImm(3, int64(ExtractBits(i.raw, 16, 4)))
+		default:
+			return nil, failedToDecodeInstruction
+		}
+		return i, nil
+	case 2:
+		i.operation = ARM64_TBL
+		i.setSveZReg(0, rd, esize)
+		i.setSveZList(1, rn, 2, esize)
+		i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+		return i, nil
+	case 3:
+		fallthrough
+	case 5:
+		i.operation = [2]Operation{ARM64_TBXQ, ARM64_TBX}[ExtractBits(i.raw, 11, 1)]
+		i.setSveZReg(0, rd, esize)
+		i.setSveZReg(1, rn, esize)
+		i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+		return i, nil
+	case 4:
+		i.operation = ARM64_TBL
+		i.setSveZReg(0, rd, esize)
+		i.setSveZList(1, rn, 1, esize)
+		i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+		return i, nil
+	case 6:
+		if ExtractBits(i.raw, 19, 2) == 1 {
+			return i.decompose_sve_pmov()
+		}
+		switch ExtractBits(i.raw, 16, 5) {
+		case 0x00:
+			i.operation = ARM64_MOV
+			i.setSveZReg(0, rd, esize)
+			i.setSveGpReg(1, sf, rn, REGSET_SP)
+		case 0x04:
+			i.operation = ARM64_INSR
+			i.setSveZReg(0, rd, esize)
+			i.setSveGpReg(1, sf, rn, REGSET_ZR)
+		case 0x14:
+			i.operation = ARM64_INSR
+			i.setSveZReg(0, rd, esize)
+			i.setSveFpReg(1, size, rn)
+		case 0x18:
+			i.operation = ARM64_REV
+			i.setSveZReg(0, rd, esize)
+			i.setSveZReg(1, rn, esize)
+		case 0x10:
+			fallthrough
+		case 0x11:
+			fallthrough
+		case 0x12:
+			fallthrough
+		case 0x13:
+			var operation = [4]Operation{ARM64_SUNPKLO, ARM64_SUNPKHI, ARM64_UUNPKLO, ARM64_UUNPKHI}
+			if size == 0 {
+				return nil, failedToDecodeInstruction
+			}
+			i.operation = operation[ExtractBits(i.raw, 16, 2)]
+			i.setSveZReg(0, rd, esize)
+			i.setSveZReg(1, rn, esize/2)
+		default:
+			return nil, failedToDecodeInstruction
+		}
+		return i, nil
+	}
+	return nil, failedToDecodeInstruction
+}
+
+func (i *Instruction) decompose_sve_permute_predicate() (*Instruction, error) {
+	/* SVE Permute Predicate
+	 *
+	 * {ZIP1|ZIP2|UZP1|UZP2|TRN1|TRN2} <Pd>.<T>, <Pn>.<T>, <Pm>.<T>
+	 * REV <Pd>.<T>, <Pn>.<T>
+	 * PUNPK{LO|HI} <Pd>.H, <Pn>.B
+	 */
+	var operation = [8]Operation{
+		ARM64_ZIP1, ARM64_ZIP2, ARM64_UZP1, ARM64_UZP2,
+		ARM64_TRN1, ARM64_TRN2, ARM64_UNDEFINED, ARM64_UNDEFINED,
+	}
+	if ExtractBits(i.raw, 9, 1) != 0 || ExtractBits(i.raw, 4, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	size := ExtractBits(i.raw, 22, 2)
+	esize := sveElementSize(size)
+	pd := ExtractBits(i.raw, 0, 4)
+	pn := ExtractBits(i.raw, 5, 4)
+	if ExtractBits(i.raw, 20, 1) == 0 {
+		i.operation = operation[ExtractBits(i.raw, 10, 3)]
+		if i.operation == ARM64_UNDEFINED {
+			return nil, failedToDecodeInstruction
+		}
+		i.setSvePReg(0, pd, esize)
+		i.setSvePReg(1, pn, esize)
+		i.setSvePReg(2, ExtractBits(i.raw, 16, 4), esize)
+		return i, nil
+	}
+	if ExtractBits(i.raw, 10, 3) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	switch ExtractBits(i.raw, 16, 4) {
+	case 4:
+		i.operation = ARM64_REV
+		i.setSvePReg(0, pd, esize)
+		i.setSvePReg(1, pn, esize)
+	case 0:
+		fallthrough
+	case 1:
+		if size != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		var operation = [2]Operation{ARM64_PUNPKLO, ARM64_PUNPKHI}
+		i.operation = operation[ExtractBits(i.raw, 16, 1)]
+		i.setSvePReg(0, pd, 2)
+		i.setSvePReg(1, pn, 1)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_permute_vector_interleave() (*Instruction, error) {
+	/* SVE Permute Vector - Interleaving
+	 *
+	 * {ZIP1|ZIP2|UZP1|UZP2|TRN1|TRN2} <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	 */
+	var operation = [8]Operation{
+		ARM64_ZIP1, ARM64_ZIP2, ARM64_UZP1, ARM64_UZP2,
+		ARM64_TRN1, ARM64_TRN2, ARM64_UNDEFINED, ARM64_UNDEFINED,
+	}
+	i.operation = operation[ExtractBits(i.raw, 10, 3)]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+	return i, nil
+}
+
+// setSvePmovZReg sets the untyped <Zn>{[<imm>]} operand of PMOV, which has no index for bytes
+func (i *Instruction) setSvePmovZReg(idx int, r, esize, index uint32) {
+	if esize == 1 {
+		i.setSveZReg(idx, r, 0)
+		return
+	}
+	i.setSveZRegIndexed(idx, r, 0, index)
+}
+
+func (i *Instruction) decompose_sve_pmov() (*Instruction, error) {
+	/* SVE Move Predicate to/from Vector
+	 *
+	 * PMOV <Pd>.<T>, <Zn>{[<imm>]}
+	 * PMOV <Zd>{[<imm>]}, <Pn>.<T>
+	 */
+	var esize, index uint32
+	switch size := ExtractBits(i.raw, 22, 2); {
+	case size&2 != 0:
+		esize = 8
+		index = (size&1)<<2 | ExtractBits(i.raw, 17, 2)
+	case size == 1:
+		esize = 4
+		index = ExtractBits(i.raw, 17, 2)
+	case ExtractBits(i.raw, 18, 1) == 1:
+		esize = 2
+		index = ExtractBits(i.raw, 17, 1)
+	case ExtractBits(i.raw, 17, 1) == 1:
+		esize = 1
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = ARM64_PMOV
+	if ExtractBits(i.raw, 16, 1) == 0 {
+		if ExtractBits(i.raw, 4, 1) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.setSvePReg(0, ExtractBits(i.raw, 0, 4), esize)
+		i.setSvePmovZReg(1, ExtractBits(i.raw, 5, 5), esize, index)
+		return i, nil
+	}
+	if ExtractBits(i.raw, 9, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	i.setSvePmovZReg(0, ExtractBits(i.raw, 0, 5), esize, index)
+	i.setSvePReg(1, ExtractBits(i.raw, 5, 4), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_permute_vector_pred() (*Instruction, error) {
+	/* SVE Permute Vector - Predicated
+	 *
+	 * CPY <Zd>.<T>, <Pg>/M, <V><n>
+	 * CPY <Zd>.<T>, <Pg>/M, <R><n|SP>
+	 * COMPACT <Zd>.<T>, <Pg>, <Zn>.<T>
+	 * LAST{A|B} <V><d>, <Pg>, <Zn>.<T>
+	 * LAST{A|B} <R><d>, <Pg>, <Zn>.<T>
+	 * CLAST{A|B} <Zdn>.<T>, <Pg>, <Zdn>.<T>, <Zm>.<T>
+	 * CLAST{A|B} <V><dn>, <Pg>, <V><dn>, <Zm>.<T>
+	 * CLAST{A|B} <R><dn>, <Pg>, <R><dn>, <Zm>.<T>
+	 * REV{B|H|W} <Zd>.<T>, <Pg>/M, <Zn>.<T>
+	 * RBIT <Zd>.<T>, <Pg>/M, <Zn>.<T>
+	 * SPLICE <Zdn>.<T>, <Pg>, <Zdn>.<T>, <Zm>.<T>
+	 * SPLICE <Zd>.<T>, <Pg>, {<Zn1>.<T>, <Zn2>.<T>}
+	 * REVD <Zd>.Q, <Pg>/M, <Zn>.Q
+	 */
+	size := ExtractBits(i.raw, 22, 2)
+	esize := sveElementSize(size)
+	rd := ExtractBits(i.raw, 0, 5)
+	rn := ExtractBits(i.raw, 5, 5)
+	pg := ExtractBits(i.raw, 10, 3)
+	opc := ExtractBits(i.raw, 16, 5)
+	var sf uint32
+	if size == 3 {
+		sf = 1
+	}
+	if ExtractBits(i.raw, 13, 1) == 1 {
+		switch opc {
+		case 0x00:
+			fallthrough
+		case 0x01:
+			var operation = [2]Operation{ARM64_LASTA, ARM64_LASTB}
+			i.operation = operation[opc&1]
+			i.setSveGpReg(0, sf, rd, REGSET_ZR)
+			i.setSvePred(1, pg, PRED_NONE)
+			i.setSveZReg(2, rn, esize)
+		case 0x08:
+			i.operation = ARM64_MOV
+			i.setSveZReg(0, rd, esize)
+			i.setSvePred(1, pg, PRED_MERGE)
+			i.setSveGpReg(2, sf, rn, REGSET_SP)
+		case 0x10:
+			fallthrough
+		case 0x11:
+			var operation = [2]Operation{ARM64_CLASTA, ARM64_CLASTB}
+			i.operation = operation[opc&1]
+			i.setSveGpReg(0, sf, rd, REGSET_ZR)
+			i.setSvePred(1, pg, PRED_NONE)
+			i.setSveGpReg(2, sf, rd, REGSET_ZR)
+			i.setSveZReg(3, rn, esize)
+		default:
+			return nil, failedToDecodeInstruction
+		}
+		return i, nil
+	}
+	switch opc {
+	case 0x00:
+		i.operation = ARM64_MOV
+		i.setSveZReg(0, rd, esize)
+		i.setSvePred(1, pg, PRED_MERGE)
+		i.setSveFpReg(2, size, rn)
+	case 0x01:
+		if size < 2 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_COMPACT
+		i.setSveZReg(0, rd, esize)
+		i.setSvePred(1, pg, PRED_NONE)
+		i.setSveZReg(2, rn, esize)
+	case 0x02:
+		fallthrough
+	case 0x03:
+		var operation = [2]Operation{ARM64_LASTA, ARM64_LASTB}
+		i.operation = operation[opc&1]
+		i.setSveFpReg(0, size, rd)
+		i.setSvePred(1, pg, PRED_NONE)
+		i.setSveZReg(2, rn, esize)
+	case 0x04:
+		fallthrough
+	case 0x05:
+		fallthrough
+	case 0x06:
+		fallthrough
+	case 0x07:
+		var operation = [4]Operation{ARM64_REVB, ARM64_REVH, ARM64_REVW, ARM64_RBIT}
+		var minSize = [4]uint32{1, 2, 3, 0}
+		if size < minSize[opc&3] {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = operation[opc&3]
+		i.setSveZReg(0, rd, esize)
+		i.setSvePred(1, pg, PRED_MERGE)
+		i.setSveZReg(2, rn, esize)
+	case 0x08:
+		fallthrough
+	case 0x09:
+		var operation = [2]Operation{ARM64_CLASTA, ARM64_CLASTB}
+		i.operation = operation[opc&1]
+		i.setSveZReg(0, rd, esize)
+		i.setSvePred(1, pg, PRED_NONE)
+		i.setSveZReg(2, rd, esize)
+		i.setSveZReg(3, rn, esize)
+	case 0x0a:
+		fallthrough
+	case 0x0b:
+		var operation = [2]Operation{ARM64_CLASTA, ARM64_CLASTB}
+		i.operation = operation[opc&1]
+		i.setSveFpReg(0, size, rd)
+		i.setSvePred(1, pg, PRED_NONE)
+		i.setSveFpReg(2, size, rd)
+		i.setSveZReg(3, rn, esize)
+	case 0x0c:
+		i.operation = ARM64_SPLICE
+		i.setSveZReg(0, rd, esize)
+		i.setSvePred(1, pg, PRED_NONE)
+		i.setSveZReg(2, rd, esize)
+		i.setSveZReg(3, rn, esize)
+	case 0x0d:
+		i.operation = ARM64_SPLICE
+		i.setSveZReg(0, rd, esize)
+		i.setSvePred(1, pg, PRED_NONE)
+		i.setSveZList(2, rn, 2, esize)
+	case 0x0e:
+		if size != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_REVD
+		i.setSveZReg(0, rd, 16)
+		i.setSvePred(1, pg, PRED_MERGE)
+		i.setSveZReg(2, rn, 16)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_select() (*Instruction, error) {
+	/* SVE Select Vector Elements
+	 *
+	 * SEL <Zd>.<T>, <Pv>, <Zn>.<T>, <Zm>.<T>
+	 * MOV <Zd>.<T>, <Pv>/M, <Zn>.<T>
+	 */
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	rd := ExtractBits(i.raw, 0, 5)
+	rn := ExtractBits(i.raw, 5, 5)
+	rm := ExtractBits(i.raw, 16, 5)
+	pv := ExtractBits(i.raw, 10, 4)
+	i.setSveZReg(0, rd, esize)
+	if rd == rm {
+		i.operation = ARM64_MOV
+		i.setSvePred(1, pv, PRED_MERGE)
+		i.setSveZReg(2, rn, esize)
+		return i, nil
+	}
+	i.operation = ARM64_SEL
+	i.setSvePred(1, pv, PRED_NONE)
+	i.setSveZReg(2, rn, esize)
+	i.setSveZReg(3, rm, esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_compare_predicate() (*Instruction, error) {
+	if ExtractBits(i.raw, 24, 1) == 0 {
+		if ExtractBits(i.raw, 21, 1) == 0 {
+			return i.decompose_sve_int_compare_vectors()
+		}
+		return i.decompose_sve_int_compare_uimm()
+	}
+	if ExtractBits(i.raw, 21, 1) == 0 {
+		switch ExtractBits(i.raw, 14, 2) {
+		case 0:
+			fallthrough
+		case 2:
+			return i.decompose_sve_int_compare_simm()
+		case 1:
+			if ExtractBits(i.raw, 20, 2) == 0 {
+				return i.decompose_sve_pred_logical()
+			}
+			return i.decompose_sve_partition_break()
+		case 3:
+			if ExtractBits(i.raw, 20, 2) == 0 {
+				return i.decompose_sve_propagate_break()
+			}
+			return i.decompose_sve_pred_misc()
+		}
+	}
+	switch ExtractBits(i.raw, 14, 2) {
+	case 0:
+		return i.decompose_sve_int_compare_scalar()
+	case 1:
+		return i.decompose_sve_pred_counter()
+	case 2:
+		return i.decompose_sve_pred_count()
+	case 3:
+		return i.decompose_sve_int_wide_imm_unpred()
+	}
+	return nil, failedToDecodeInstruction
+}
+
+func (i *Instruction) setSveCompare(operation Operation, zmWide bool) {
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	i.operation = operation
+	i.setSvePReg(0, ExtractBits(i.raw, 0, 4), esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_ZERO)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+	if zmWide {
+		i.setSveZReg(3, ExtractBits(i.raw, 16, 5), 8)
+	} else {
+		i.setSveZReg(3, ExtractBits(i.raw, 16, 5), esize)
+	}
+}
+
+func (i *Instruction) decompose_sve_int_compare_vectors() (*Instruction, error) {
+	/* SVE Integer Compare - Vectors
+	 *
+	 * CMP<cc> <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>
+	 * CMP<cc> <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.D
+	 */
+	var operation = [8][2]Operation{
+		{ARM64_CMPHS, ARM64_CMPHI},
+		{ARM64_CMPEQ, ARM64_CMPNE},
+		{ARM64_CMPGE, ARM64_CMPGT},
+		{ARM64_CMPLT, ARM64_CMPLE},
+		{ARM64_CMPGE, ARM64_CMPGT},
+		{ARM64_CMPEQ, ARM64_CMPNE},
+		{ARM64_CMPHS, ARM64_CMPHI},
+		{ARM64_CMPLO, ARM64_CMPLS},
+	}
+	op := ExtractBits(i.raw, 13, 3)
+	wide := op != 0 && op != 4 && op != 5
+	if wide && ExtractBits(i.raw, 22, 2) == 3 {
+		return nil, failedToDecodeInstruction
+	}
+	i.setSveCompare(operation[op][ExtractBits(i.raw, 4, 1)], wide)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_int_compare_uimm() (*Instruction, error) {
+	/* SVE Integer Compare - Unsigned Immediate
+	 *
+	 * CMP<cc> <Pd>.<T>, <Pg>/Z, <Zn>.<T>, #<imm>
+	 */
+	var operation = [2][2]Operation{
+		{ARM64_CMPHS, ARM64_CMPHI},
+		{ARM64_CMPLO, ARM64_CMPLS},
+	}
+	i.setSveCompare(operation[ExtractBits(i.raw, 13, 1)][ExtractBits(i.raw, 4, 1)], false)
+	i.setSveImm(3, int64(ExtractBits(i.raw, 14, 7)))
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_int_compare_simm() (*Instruction, error) {
+	/* SVE Integer Compare - Signed Immediate
+	 *
+	 * CMP<cc> <Pd>.<T>, <Pg>/Z, <Zn>.<T>, #<imm>
+	 */
+	var operation = [4][2]Operation{
+		{ARM64_CMPGE, ARM64_CMPGT},
+		{ARM64_CMPLT, ARM64_CMPLE},
+		{ARM64_CMPEQ, ARM64_CMPNE},
+		{ARM64_UNDEFINED, ARM64_UNDEFINED},
+	}
+	op := ExtractBits(i.raw, 15, 1)<<1 | ExtractBits(i.raw, 13, 1)
+	if operation[op][0] == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	i.setSveCompare(operation[op][ExtractBits(i.raw, 4, 1)], false)
+	i.setSveImm(3, int64(int32(ExtractBits(i.raw, 16, 5)<<27)>>27))
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_pred_logical() (*Instruction, error) {
+	/* SVE Predicate Logical Operations
+	 *
+	 * {AND|BIC|EOR|ORR|ORN|NOR|NAND}{S} <Pd>.B, <Pg>/Z, <Pn>.B, <Pm>.B
+	 * SEL <Pd>.B, <Pg>, <Pn>.B, <Pm>.B
+	 */
+	var operation = [16]Operation{
+		ARM64_AND, ARM64_BIC, ARM64_EOR, ARM64_SEL,
+		ARM64_ANDS, ARM64_BICS, ARM64_EORS, ARM64_UNDEFINED,
+		ARM64_ORR, ARM64_ORN, ARM64_NOR, ARM64_NAND,
+		ARM64_ORRS, ARM64_ORNS, ARM64_NORS, ARM64_NANDS,
+	}
+	op := ExtractBits(i.raw, 22, 2)<<2 | ExtractBits(i.raw, 9, 1)<<1 | ExtractBits(i.raw, 4, 1)
+	i.operation = operation[op]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	pd := ExtractBits(i.raw, 0, 4)
+	pn := ExtractBits(i.raw, 5, 4)
+	pg := ExtractBits(i.raw, 10, 4)
+	pm := ExtractBits(i.raw, 16, 4)
+	i.setSvePReg(0, pd, 1)
+	switch {
+	case (i.operation == ARM64_ORR || i.operation == ARM64_ORRS) && pn == pm && pn == pg:
+		if i.operation == ARM64_ORR {
+			i.operation = ARM64_MOV
+		} else {
+			i.operation = ARM64_MOVS
+		}
+		i.setSvePReg(1, pn, 1)
+		return i, nil
+	case (i.operation == ARM64_AND || i.operation == ARM64_ANDS) && pn == pm:
+		if i.operation == ARM64_AND {
+			i.operation = ARM64_MOV
+		} else {
+			i.operation = ARM64_MOVS
+		}
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSvePReg(2, pn, 1)
+		return i, nil
+	case (i.operation == ARM64_EOR || i.operation == ARM64_EORS) && pm == pg:
+		if i.operation == ARM64_EOR {
+			i.operation = ARM64_NOT
+		} else {
+			i.operation = ARM64_NOTS
+		}
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSvePReg(2, pn, 1)
+		return i, nil
+	case i.operation == ARM64_SEL && pd == pm:
+		i.operation = ARM64_MOV
+		i.setSvePred(1, pg, PRED_MERGE)
+		i.setSvePReg(2, pn, 1)
+		return i, nil
+	case i.operation == ARM64_SEL:
+		i.setSvePred(1, pg, PRED_NONE)
+	default:
+		i.setSvePred(1, pg, PRED_ZERO)
+	}
+	i.setSvePReg(2, pn, 1)
+	i.setSvePReg(3, pm, 1)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_partition_break() (*Instruction, error) {
+	/* SVE Partition Break
+	 *
+	 * BRK{A|B}{S} <Pd>.B, <Pg>/<ZM>, <Pn>.B
+	 * BRKN{S} <Pdm>.B, <Pg>/Z, <Pn>.B, <Pdm>.B
+	 */
+	if ExtractBits(i.raw, 20, 2) != 1 || ExtractBits(i.raw, 9, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	var brk = [2][2]Operation{{ARM64_BRKA, ARM64_BRKAS}, {ARM64_BRKB, ARM64_BRKBS}}
+	var brkn = [2]Operation{ARM64_BRKN, ARM64_BRKNS}
+	b := ExtractBits(i.raw, 23, 1)
+	s := ExtractBits(i.raw, 22, 1)
+	m := ExtractBits(i.raw, 4, 1)
+	pd := ExtractBits(i.raw, 0, 4)
+	pn := ExtractBits(i.raw, 5, 4)
+	pg := ExtractBits(i.raw, 10, 4)
+	switch ExtractBits(i.raw, 16, 4) {
+	case 0:
+		if s == 1 && m == 1 {
+			return nil, failedToDecodeInstruction
+		}
+		var qual = [2]PredicateQualifier{PRED_ZERO, PRED_MERGE}
+		i.operation = brk[b][s]
+		i.setSvePReg(0, pd, 1)
+		i.setSvePred(1, pg, qual[m])
+		i.setSvePReg(2, pn, 1)
+	case 8:
+		if b == 1 || m == 1 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = brkn[s]
+		i.setSvePReg(0, pd, 1)
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSvePReg(2, pn, 1)
+		i.setSvePReg(3, pd, 1)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_propagate_break() (*Instruction, error) {
+	/* SVE Propagate Break
+	 *
+	 * BRKP{A|B}{S} <Pd>.B, <Pg>/Z, <Pn>.B, <Pm>.B
+	 */
+	var operation = [2][2]Operation{{ARM64_BRKPA, ARM64_BRKPB}, {ARM64_BRKPAS, ARM64_BRKPBS}}
+	if ExtractBits(i.raw, 23, 1) != 0 || ExtractBits(i.raw, 9, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = operation[ExtractBits(i.raw, 22, 1)][ExtractBits(i.raw, 4, 1)]
+	i.setSvePReg(0, ExtractBits(i.raw, 0, 4), 1)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 4), PRED_ZERO)
+	i.setSvePReg(2, ExtractBits(i.raw, 5, 4), 1)
+	i.setSvePReg(3, ExtractBits(i.raw, 16, 4), 1)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_pred_misc() (*Instruction, error) {
+	/* SVE Predicate Misc
+	 *
+	 * PTEST <Pg>, <Pn>.B
+	 * PFIRST <Pdn>.B, <Pg>, <Pdn>.B
+	 * PNEXT <Pdn>.<T>, <Pv>, <Pdn>.<T>
+	 * PTRUE{S} <Pd>.<T>{, <pattern>}
+	 * PFALSE <Pd>.B
+	 * RDFFR{S} <Pd>.B, <Pg>/Z
+	 * RDFFR <Pd>.B
+	 */
+	if ExtractBits(i.raw, 20, 2) != 1 || ExtractBits(i.raw, 4, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	size := ExtractBits(i.raw, 22, 2)
+	pd := ExtractBits(i.raw, 0, 4)
+	pn := ExtractBits(i.raw, 5, 4)
+	op := ExtractBits(i.raw, 16, 4)
+	op2 := ExtractBits(i.raw, 9, 5)
+	switch {
+	case op == 0 && size == 1 && ExtractBits(i.raw, 9, 1) == 0 && pd == 0:
+		i.operation = ARM64_PTEST
+		i.setSvePred(0, ExtractBits(i.raw, 10, 4), PRED_NONE)
+		i.setSvePReg(1, pn, 1)
+	case op == 8 && size == 1 && op2 == 0:
+		i.operation = ARM64_PFIRST
+		i.setSvePReg(0, pd, 1)
+		i.setSvePred(1, pn, PRED_NONE)
+		i.setSvePReg(2, pd, 1)
+	case op == 9 && op2 == 2:
+		esize := sveElementSize(size)
+		i.operation = ARM64_PNEXT
+		i.setSvePReg(0, pd, esize)
+		i.setSvePred(1, pn, PRED_NONE)
+		i.setSvePReg(2, pd, esize)
+	case op&0xe == 8 && op2&0x1e == 0x10:
+		var operation = [2]Operation{ARM64_PTRUE, ARM64_PTRUES}
+		i.operation = operation[op&1]
+		i.setSvePReg(0, pd, sveElementSize(size))
+		i.setSveCountPattern(1, ExtractBits(i.raw, 5, 5), 1)
+	case op == 8 && size == 0 && op2 == 0x12 && pn == 0:
+		i.operation = ARM64_PFALSE
+		i.setSvePReg(0, pd, 1)
+	case op == 8 && size&2 == 0 && op2&0x1e == 0x18 && ExtractBits(i.raw, 9, 1) == 0:
+		var operation = [2]Operation{ARM64_RDFFR, ARM64_RDFFRS}
+		i.operation = operation[size&1]
+		i.setSvePReg(0, pd, 1)
+		i.setSvePred(1, pn, PRED_ZERO)
+	case op == 9 && size == 0 && op2 == 0x18 && pn == 0:
+		i.operation = ARM64_RDFFR
+		i.setSvePReg(0, pd, 1)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_int_compare_scalar() (*Instruction, error) {
+	/* SVE Integer Compare - Scalars
+	 *
+	 * WHILE{GE|GT|HS|HI|LT|LE|LO|LS} <Pd>.<T>, <R><n>, <R><m>
+	 * WHILE{WR|RW} <Pd>.<T>, <Xn>, <Xm>
+	 * CTERM{EQ|NE} <R><n>, <R><m>
+	 */
+	var operation = [2][2][2]Operation{
+		{{ARM64_WHILEGE, ARM64_WHILEGT}, {ARM64_WHILEHS, ARM64_WHILEHI}},
+		{{ARM64_WHILELT, ARM64_WHILELE}, {ARM64_WHILELO, ARM64_WHILELS}},
+	}
+	rn := ExtractBits(i.raw, 5, 5)
+	rm := ExtractBits(i.raw, 16, 5)
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	switch op := ExtractBits(i.raw, 10, 4); {
+	case op < 8:
+		sf := ExtractBits(i.raw, 12, 1)
+		i.operation = operation[op&1][ExtractBits(i.raw, 11, 1)][ExtractBits(i.raw, 4, 1)]
+		i.setSvePReg(0, ExtractBits(i.raw, 0, 4), esize)
+		i.setSveGpReg(1, sf, rn, REGSET_ZR)
+		i.setSveGpReg(2, sf, rm, REGSET_ZR)
+	case op == 8:
+		if ExtractBits(i.raw, 23, 1) != 1 || ExtractBits(i.raw, 0, 4) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		var cterm = [2]Operation{ARM64_CTERMEQ, ARM64_CTERMNE}
+		sf := ExtractBits(i.raw, 22, 1)
+		i.operation = cterm[ExtractBits(i.raw, 4, 1)]
+		i.setSveGpReg(0, sf, rn, REGSET_ZR)
+		i.setSveGpReg(1, sf, rm, REGSET_ZR)
+	case op == 12:
+		i.operation = [2]Operation{ARM64_WHILEWR, ARM64_WHILERW}[ExtractBits(i.raw, 4, 1)]
+		i.setSvePReg(0, ExtractBits(i.raw, 0, 4), esize)
+		i.setSveGpReg(1, 1, rn, REGSET_ZR)
+		i.setSveGpReg(2, 1, rm, REGSET_ZR)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_pred_counter() (*Instruction, error) {
+	/* SVE Predicate-as-Counter and Predicate Select
+	 *
+	 * WHILE{GE|GT|HS|HI|LT|LE|LO|LS} <PNd>.<T>, <Xn>, <Xm>, <vl>
+	 * WHILE{GE|GT|HS|HI|LT|LE|LO|LS} {<Pd1>.<T>, <Pd2>.<T>}, <Xn>, <Xm>
+	 * PEXT <Pd>.<T>, <PNn>[<imm>]
+	 * PEXT {<Pd1>.<T>, <Pd2>.<T>}, <PNn>[<imm>]
+	 * PTRUE <PNd>.<T>
+	 * PSEL <Pd>, <Pn>, <Pm>.<T>[<Wv>, <imm>]
+	 */
+	var operation = [2][2][2]Operation{
+		{{ARM64_WHILEGE, ARM64_WHILEGT}, {ARM64_WHILEHS, ARM64_WHILEHI}},
+		{{ARM64_WHILELT, ARM64_WHILELE}, {ARM64_WHILELO, ARM64_WHILELS}},
+	}
+	if ExtractBits(i.raw, 4, 1) == 0 {
+		return i.decompose_sve_psel()
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	rn := ExtractBits(i.raw, 5, 5)
+	rm := ExtractBits(i.raw, 16, 5)
+	lt := ExtractBits(i.raw, 10, 1)
+	u := ExtractBits(i.raw, 11, 1)
+	switch op := ExtractBits(i.raw, 10, 4); {
+	case op&4 == 0:
+		i.operation = operation[lt][u][ExtractBits(i.raw, 3, 1)]
+		i.setSvePnReg(0, ExtractBits(i.raw, 0, 3)+8, esize)
+		i.setSveGpReg(1, 1, rn, REGSET_ZR)
+		i.setSveGpReg(2, 1, rm, REGSET_ZR)
+		i.setSvePattern(3, uint32(SVE_VLX2)+ExtractBits(i.raw, 13, 1), 1)
+	case op>>2 == 1:
+		i.operation = operation[lt][u][ExtractBits(i.raw, 0, 1)]
+		i.setSvePList(0, ExtractBits(i.raw, 1, 3)<<1, 2, esize)
+		i.setSveGpReg(1, 1, rn, REGSET_ZR)
+		i.setSveGpReg(2, 1, rm, REGSET_ZR)
+	case op == 0xc:
+		if rm != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_PEXT
+		i.setSvePReg(0, ExtractBits(i.raw, 0, 4), esize)
+		i.setSvePnRegIndexed(1, ExtractBits(i.raw, 5, 3)+8, ExtractBits(i.raw, 8, 2))
+	case op == 0xd:
+		if rm != 0 || ExtractBits(i.raw, 9, 1) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_PEXT
+		i.setSvePList(0, ExtractBits(i.raw, 0, 4), 2, esize)
+		i.setSvePnRegIndexed(1, ExtractBits(i.raw, 5, 3)+8, ExtractBits(i.raw, 8, 1))
+	case op == 0xe:
+		if rm != 0 || ExtractBits(i.raw, 3, 7) != 2 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_PTRUE
+		i.setSvePnReg(0, ExtractBits(i.raw, 0, 3)+8, esize)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_psel() (*Instruction, error) {
+	/* SVE Predicate Select
+	 *
+	 * PSEL <Pd>, <Pn>, <Pm>.<T>[<Wv>, <imm>]
+	 */
+	if ExtractBits(i.raw, 9, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	imm := ExtractBits(i.raw, 22, 2)<<3 | ExtractBits(i.raw, 18, 3)
+	var esize uint32
+	switch {
+	case imm&0x1 != 0:
+		esize = 1
+	case imm&0x2 != 0:
+		esize = 2
+	case imm&0x4 != 0:
+		esize = 4
+	case imm&0x8 != 0:
+		esize = 8
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = ARM64_PSEL
+	i.setSvePReg(0, ExtractBits(i.raw, 0, 4), 0)
+	i.setSvePReg(1, ExtractBits(i.raw, 10, 4), 0)
+	i.setSvePReg(2, ExtractBits(i.raw, 5, 4), esize)
+	i.operands[2].IndexReg = reg(REGSET_ZR, REG_W_BASE, int(12+ExtractBits(i.raw, 16, 2)))
+	i.operands[2].Immediate = uint64(imm / (esize << 1))
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_pred_count() (*Instruction, error) {
+	/* SVE Predicate Count
+	 *
+	 * CNTP <Xd>, <Pg>, <Pn>.<T>
+	 * CNTP <Xd>, <PNn>.<T>, <vl>
+	 * {INC|DEC}P <Xdn>, <Pm>.<T>
+	 * {INC|DEC}P <Zdn>.<T>, <Pm>.<T>
+	 * {SQ|UQ}{INC|DEC}P <Xdn>, <Pm>.<T>{, <Wdn>}
+	 * {SQ|UQ}{INC|DEC}P <Zdn>.<T>, <Pm>.<T>
+	 * SETFFR
+	 * WRFFR <Pn>.B
+	 */
+	var satOperation = [4]Operation{ARM64_SQINCP, ARM64_UQINCP, ARM64_SQDECP, ARM64_UQDECP}
+	var operation = [2]Operation{ARM64_INCP, ARM64_DECP}
+	size := ExtractBits(i.raw, 22, 2)
+	esize := sveElementSize(size)
+	rd := ExtractBits(i.raw, 0, 5)
+	pn := ExtractBits(i.raw, 5, 4)
+	op := ExtractBits(i.raw, 16, 5)
+	opc := ExtractBits(i.raw, 9, 5)
+	switch {
+	case op == 0 && ExtractBits(i.raw, 9, 1) == 0:
+		i.operation = ARM64_CNTP
+		i.setSveGpReg(0, 1, rd, REGSET_ZR)
+		i.setSvePred(1, ExtractBits(i.raw, 10, 4), PRED_NONE)
+		i.setSvePReg(2, pn, esize)
+	case op == 0 && opc&0x1d == 0x01:
+		i.operation = ARM64_CNTP
+		i.setSveGpReg(0, 1, rd, REGSET_ZR)
+		i.setSvePnReg(1, pn, esize)
+		i.setSvePattern(2, uint32(SVE_VLX2)+ExtractBits(i.raw, 10, 1), 1)
+	case op&0x1c == 0x08 && opc == 0 && size != 0:
+		i.operation = satOperation[op&3]
+		i.setSveZReg(0, rd, esize)
+		i.setSvePReg(1, pn, esize)
+	case op&0x1c == 0x08 && opc&0x1c == 0x04 && ExtractBits(i.raw, 9, 1) == 0:
+		i.operation = satOperation[op&3]
+		sf := ExtractBits(i.raw, 10, 1)
+		if op&1 == 1 {
+			i.setSveGpReg(0, sf, rd, REGSET_ZR)
+			i.setSvePReg(1, pn, esize)
+		} else {
+			i.setSveGpReg(0, 1, rd, REGSET_ZR)
+			i.setSvePReg(1, pn, esize)
+			if sf == 0 {
+				i.setSveGpReg(2, 0, rd, REGSET_ZR)
+			}
+		}
+	case op&0x1e == 0x0c && opc == 0 && size != 0:
+		i.operation = operation[op&1]
+		i.setSveZReg(0, rd, esize)
+		i.setSvePReg(1, pn, esize)
+	case op&0x1e == 0x0c && opc == 0x04:
+		i.operation = operation[op&1]
+		i.setSveGpReg(0, 1, rd, REGSET_ZR)
+		i.setSvePReg(1, pn, esize)
+	case op == 0x0c && size == 0 && opc == 0x08 && ExtractBits(i.raw, 0, 9) == 0:
+		i.operation = ARM64_SETFFR
+	case op == 0x08 && size == 0 && opc == 0x08 && ExtractBits(i.raw, 0, 5) == 0:
+		i.operation = ARM64_WRFFR
+		i.setSvePReg(0, pn, 1)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_int_wide_imm_unpred() (*Instruction, error) {
+	/* SVE Integer Wide Immediate - Unpredicated
+	 *
+	 * {ADD|SUB|SUBR|SQADD|UQADD|SQSUB|UQSUB} <Zdn>.<T>, <Zdn>.<T>, #<imm>{, <shift>}
+	 * {SMAX|UMAX|SMIN|UMIN|MUL} <Zdn>.<T>, <Zdn>.<T>, #<imm>
+	 * DUP <Zd>.<T>, #<imm>{, <shift>}
+	 * FDUP <Zd>.<T>, #<const>
+	 */
+	var arith = [8]Operation{
+		ARM64_ADD, ARM64_SUB, ARM64_UNDEFINED, ARM64_SUBR,
+		ARM64_SQADD, ARM64_UQADD, ARM64_SQSUB, ARM64_UQSUB,
+	}
+	var minmax = [4]Operation{ARM64_SMAX, ARM64_UMAX, ARM64_SMIN, ARM64_UMIN}
+	size := ExtractBits(i.raw, 22, 2)
+	esize := sveElementSize(size)
+	rd := ExtractBits(i.raw, 0, 5)
+	imm8 := ExtractBits(i.raw, 5, 8)
+	sh := ExtractBits(i.raw, 13, 1)
+	op := ExtractBits(i.raw, 16, 5)
+	if size == 0 && sh == 1 {
+		return nil, failedToDecodeInstruction
+	}
+	switch {
+	case op&0x18 == 0:
+		i.operation = arith[op&7]
+		if i.operation == ARM64_UNDEFINED {
+			return nil, failedToDecodeInstruction
+		}
+		i.setSveZReg(0, rd, esize)
+		i.setSveZReg(1, rd, esize)
+		i.setSveImm8OptLsl(2, imm8, sh, false)
+	case op&0x1c == 0x08 && sh == 0:
+		i.operation = minmax[op&3]
+		i.setSveZReg(0, rd, esize)
+		i.setSveZReg(1, rd, esize)
+		i.setSveImm8OptLsl(2, imm8, 0, op&1 == 0)
+	case op == 0x10 && sh == 0:
+		i.operation = ARM64_MUL
+		i.setSveZReg(0, rd, esize)
+		i.setSveZReg(1, rd, esize)
+		i.setSveImm8OptLsl(2, imm8, 0, true)
+	case op == 0x18:
+		i.operation = ARM64_MOV
+		i.setSveZReg(0, rd, esize)
+		i.setSveImm8OptLsl(1, imm8, sh, true)
+	case op == 0x19 && sh == 0 && size != 0:
+		i.operation = ARM64_FMOV
+		i.setSveZReg(0, rd, esize)
+		i.operands[1].OpClass = FIMM32
+		i.operands[1].Immediate = uint64(vFPExpandImm(imm8))
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_int_dot() (*Instruction, error) {
+	/* SVE Integer Dot Product
+	 *
+	 * {SDOT|UDOT} <Zda>.<T>, <Zn>.<Tb>, <Zm>.<Tb>
+	 * {SDOT|UDOT} <Zda>.<T>, <Zn>.<Tb>, <Zm>.<Tb>[<imm>]
+	 */
+	var operation = [2]Operation{ARM64_SDOT, ARM64_UDOT}
+	size := ExtractBits(i.raw, 22, 2)
+	if size < 2 {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(size)
+	i.operation = operation[ExtractBits(i.raw, 10, 1)]
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize/4)
+	if ExtractBits(i.raw, 21, 1) == 0 {
+		i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize/4)
+	} else if size == 2 {
+		i.setSveZRegIndexed(2, ExtractBits(i.raw, 16, 3), 1, ExtractBits(i.raw, 19, 2))
+	} else {
+		i.setSveZRegIndexed(2, ExtractBits(i.raw, 16, 4), 2, ExtractBits(i.raw, 20, 1))
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_floating_point() (*Instruction, error) {
+	if ExtractBits(i.raw, 22, 2) == 0 && (ExtractBits(i.raw, 24, 1) == 1 || ExtractBits(i.raw, 21, 1) == 0) &&
+		(ExtractBits(i.raw, 21, 1) != 0 || ExtractBits(i.raw, 13, 3) != 5) {
+		return nil, failedToDecodeInstruction
+	}
+	if ExtractBits(i.raw, 24, 1) == 0 {
+		if ExtractBits(i.raw, 21, 1) == 0 {
+			if ExtractBits(i.raw, 15, 1) == 0 {
+				return i.decompose_sve_fp_complex_mla()
+			}
+			switch {
+			case ExtractBits(i.raw, 17, 4) == 0 && ExtractBits(i.raw, 13, 3) == 4:
+				return i.decompose_sve_fp_complex_add()
+			case ExtractBits(i.raw, 19, 2) == 2 && ExtractBits(i.raw, 13, 3) == 4:
+				return i.decompose_sve2_fp_pairwise()
+			case ExtractBits(i.raw, 18, 3) == 2 && ExtractBits(i.raw, 13, 3) == 5:
+				return i.decompose_sve2_fp_convert_odd()
+			case ExtractBits(i.raw, 19, 2) == 2 && ExtractBits(i.raw, 13, 3) == 5:
+				return i.decompose_sve2_fp_reduce_quadword()
+			}
+			return nil, failedToDecodeInstruction
+		}
+		switch ExtractBits(i.raw, 12, 4) {
+		case 0:
+			return i.decompose_sve_fp_mla_indexed()
+		case 1:
+			return i.decompose_sve_fp_complex_mla_indexed()
+		case 2:
+			if ExtractBits(i.raw, 10, 2) == 1 {
+				return i.decompose_sve2_fp_clamp()
+			}
+			return i.decompose_sve_fp_mul_indexed()
+		case 4:
+			fallthrough
+		case 6:
+			return i.decompose_sve2_fp_widening_indexed()
+		case 8:
+			fallthrough
+		case 10:
+			return i.decompose_sve2_fp_widening()
+		case 14:
+			return i.decompose_sve2_fp_mmla()
+		}
+		return nil, failedToDecodeInstruction
+	}
+	if ExtractBits(i.raw, 21, 1) == 1 {
+		return i.decompose_sve_fp_mla_pred()
+	}
+	switch ExtractBits(i.raw, 13, 3) {
+	case 0:
+		return i.decompose_sve_fp_arith_unpred()
+	case 1:
+		switch ExtractBits(i.raw, 19, 2) {
+		case 0:
+			return i.decompose_sve_fp_reduce()
+		case 1:
+			return i.decompose_sve_fp_unary_unpred()
+		case 2:
+			return i.decompose_sve_fp_compare_zero()
+		case 3:
+			return i.decompose_sve_fp_serial_reduce()
+		}
+	case 4:
+		switch ExtractBits(i.raw, 19, 2) {
+		case 0:
+			fallthrough
+		case 1:
+			return i.decompose_sve_fp_arith_pred()
+		case 2:
+			return i.decompose_sve_fp_trig_mla()
+		case 3:
+			return i.decompose_sve_fp_arith_imm_pred()
+		}
+	case 5:
+		return i.decompose_sve_fp_unary_pred()
+	default:
+		return i.decompose_sve_fp_compare_vectors()
+	}
+	return nil, failedToDecodeInstruction
+}
+
+func (i *Instruction) decompose_sve_fp_complex_mla() (*Instruction, error) {
+	/* SVE Floating-point Complex Multiply-Add
+	 *
+	 * FCMLA <Zda>.<T>, <Pg>/M, <Zn>.<T>, <Zm>.<T>, #<const>
+	 */
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	i.operation = ARM64_FCMLA
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZReg(3, ExtractBits(i.raw, 16, 5), esize)
+	i.setSveImm(4, int64(ExtractBits(i.raw, 13, 2)*90))
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_fp_complex_add() (*Instruction, error) {
+	/* SVE Floating-point Complex Add
+	 *
+	 * FCADD <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, <Zm>.<T>, #<const>
+	 */
+	var rotate = [2]int64{90, 270}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	rd := ExtractBits(i.raw, 0, 5)
+	i.operation = ARM64_FCADD
+	i.setSveZReg(0, rd, esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+	i.setSveZReg(2, rd, esize)
+	i.setSveZReg(3, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveImm(4, rotate[ExtractBits(i.raw, 16, 1)])
+	return i, nil
+}
+
+// setSveFpIndexedOperands sets <Zd>.<T>, <Zn>.<T>, <Zm>.<T>[<imm>] for the half/single/double indexed forms
+func (i *Instruction) setSveFpIndexedOperands() {
+	var esize, zm, index uint32
+	switch ExtractBits(i.raw, 22, 2) {
+	case 0:
+		fallthrough
+	case 1:
+		esize = 2
+		zm = ExtractBits(i.raw, 16, 3)
+		index = ExtractBits(i.raw, 22, 1)<<2 | ExtractBits(i.raw, 19, 2)
+	case 2:
+		esize = 4
+		zm = ExtractBits(i.raw, 16, 3)
+		index = ExtractBits(i.raw, 19, 2)
+	case 3:
+		esize = 8
+		zm = ExtractBits(i.raw, 16, 4)
+		index = ExtractBits(i.raw, 20, 1)
+	}
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZRegIndexed(2, zm, esize, index)
+}
+
+func (i *Instruction) decompose_sve_fp_mla_indexed() (*Instruction, error) {
+	/* SVE Floating-point Multiply-Add (Indexed)
+	 *
+	 * {FMLA|FMLS} <Zda>.<T>, <Zn>.<T>, <Zm>.<T>[<imm>]
+	 */
+	var operation = [2]Operation{ARM64_FMLA, ARM64_FMLS}
+	if ExtractBits(i.raw, 11, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = operation[ExtractBits(i.raw, 10, 1)]
+	i.setSveFpIndexedOperands()
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_fp_mul_indexed() (*Instruction, error) {
+	/* SVE Floating-point Multiply (Indexed)
+	 *
+	 * FMUL <Zd>.<T>, <Zn>.<T>, <Zm>.<T>[<imm>]
+	 */
+	if ExtractBits(i.raw, 10, 2) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = ARM64_FMUL
+	i.setSveFpIndexedOperands()
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_fp_complex_mla_indexed() (*Instruction, error) {
+	/* SVE Floating-point Complex Multiply-Add (Indexed)
+	 *
+	 * FCMLA <Zda>.H, <Zn>.H, <Zm>.H[<imm>], #<const>
+	 * FCMLA <Zda>.S, <Zn>.S, <Zm>.S[<imm>], #<const>
+	 */
+	var esize, zm, index uint32
+	switch ExtractBits(i.raw, 22, 2) {
+	case 2:
+		esize = 2
+		zm = ExtractBits(i.raw, 16, 3)
+		index = ExtractBits(i.raw, 19, 2)
+	case 3:
+		esize = 4
+		zm = ExtractBits(i.raw, 16, 4)
+		index = ExtractBits(i.raw, 20, 1)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = ARM64_FCMLA
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZRegIndexed(2, zm, esize, index)
+	i.setSveImm(3, int64(ExtractBits(i.raw, 10, 2)*90))
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_fp_mla_pred() (*Instruction, error) {
+	/* SVE Floating-point Multiply-Add
+	 *
+	 * {FMLA|FMLS|FNMLA|FNMLS} <Zda>.<T>, <Pg>/M, <Zn>.<T>, <Zm>.<T>
+	 * {FMAD|FMSB|FNMAD|FNMSB} <Zdn>.<T>, <Pg>/M, <Zm>.<T>, <Za>.<T>
+	 */
+	var operation = [8]Operation{
+		ARM64_FMLA, ARM64_FMLS, ARM64_FNMLA, ARM64_FNMLS,
+		ARM64_FMAD, ARM64_FMSB, ARM64_FNMAD, ARM64_FNMSB,
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	i.operation = operation[ExtractBits(i.raw, 13, 3)]
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZReg(3, ExtractBits(i.raw, 16, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_fp_arith_unpred() (*Instruction, error) {
+	/* SVE Floating-point Arithmetic (Unpredicated)
+	 *
+	 * {FADD|FSUB|FMUL|FTSMUL|FRECPS|FRSQRTS} <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	 */
+	var operation = [8]Operation{
+		ARM64_FADD, ARM64_FSUB, ARM64_FMUL, ARM64_FTSMUL,
+		ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_FRECPS, ARM64_FRSQRTS,
+	}
+	i.operation = operation[ExtractBits(i.raw, 10, 3)]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_fp_reduce() (*Instruction, error) {
+	/* SVE Floating-point Recursive Reduction
+	 *
+	 * {FADDV|FMAXNMV|FMINNMV|FMAXV|FMINV} <V><d>, <Pg>, <Zn>.<T>
+	 */
+	var operation = [8]Operation{
+		ARM64_FADDV, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+		ARM64_FMAXNMV, ARM64_FMINNMV, ARM64_FMAXV, ARM64_FMINV,
+	}
+	i.operation = operation[ExtractBits(i.raw, 16, 3)]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	size := ExtractBits(i.raw, 22, 2)
+	i.setSveFpReg(0, size, ExtractBits(i.raw, 0, 5))
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_NONE)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), sveElementSize(size))
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_fp_unary_unpred() (*Instruction, error) {
+	/* SVE Floating-point Unary Operations (Unpredicated)
+	 *
+	 * {FRECPE|FRSQRTE} <Zd>.<T>, <Zn>.<T>
+	 */
+	var operation = [2]Operation{ARM64_FRECPE, ARM64_FRSQRTE}
+	if ExtractBits(i.raw, 17, 2) != 3 || ExtractBits(i.raw, 10, 3) != 4 {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	i.operation = operation[ExtractBits(i.raw, 16, 1)]
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_fp_compare_zero() (*Instruction, error) {
+	/* SVE Floating-point Compare with Zero
+	 *
+	 * FCM<cc> <Pd>.<T>, <Pg>/Z, <Zn>.<T>, #0.0
+	 */
+	var operation = [8]Operation{
+		ARM64_FCMGE, ARM64_FCMGT, ARM64_FCMLT, ARM64_FCMLE,
+		ARM64_FCMEQ, ARM64_UNDEFINED, ARM64_FCMNE, ARM64_UNDEFINED,
+	}
+	if ExtractBits(i.raw, 18, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = operation[ExtractBits(i.raw, 16, 2)<<1|ExtractBits(i.raw, 4, 1)]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	i.setSvePReg(0, ExtractBits(i.raw, 0, 4), esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_ZERO)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+	i.operands[3].OpClass = FIMM32
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_fp_serial_reduce() (*Instruction, error) {
+	/* SVE Floating-point Serial Reduction
+	 *
+	 * FADDA <V><dn>, <Pg>, <V><dn>, <Zm>.<T>
+	 */
+	if ExtractBits(i.raw, 16, 3) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	size := ExtractBits(i.raw, 22, 2)
+	rd := ExtractBits(i.raw, 0, 5)
+	i.operation = ARM64_FADDA
+	i.setSveFpReg(0, size, rd)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_NONE)
+	i.setSveFpReg(2, size, rd)
+	i.setSveZReg(3, ExtractBits(i.raw, 5, 5), sveElementSize(size))
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_fp_arith_pred() (*Instruction, error) {
+	/* SVE Floating-point Arithmetic (Predicated)
+	 *
+	 * <op> <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, <Zm>.<T>
+	 */
+	var operation = [16]Operation{
+		ARM64_FADD, ARM64_FSUB, ARM64_FMUL, ARM64_FSUBR,
+		ARM64_FMAXNM, ARM64_FMINNM, ARM64_FMAX, ARM64_FMIN,
+		ARM64_FABD, ARM64_FSCALE, ARM64_FMULX, ARM64_UNDEFINED,
+		ARM64_FDIVR, ARM64_FDIV, ARM64_UNDEFINED, ARM64_UNDEFINED,
+	}
+	i.operation = operation[ExtractBits(i.raw, 16, 4)]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	rd := ExtractBits(i.raw, 0, 5)
+	i.setSveZReg(0, rd, esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+	i.setSveZReg(2, rd, esize)
+	i.setSveZReg(3, ExtractBits(i.raw, 5, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_fp_trig_mla() (*Instruction, error) {
+	/* SVE Floating-point Trig Multiply-Add Coefficient
+	 *
+	 * FTMAD <Zdn>.<T>, <Zdn>.<T>, <Zm>.<T>, #<imm>
+	 */
+	if ExtractBits(i.raw, 10, 3) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	rd := ExtractBits(i.raw, 0, 5)
+	i.operation = ARM64_FTMAD
+	i.setSveZReg(0, rd, esize)
+	i.setSveZReg(1, rd, esize)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveImm(3, int64(ExtractBits(i.raw, 16, 3)))
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_fp_arith_imm_pred() (*Instruction, error) {
+	/* SVE Floating-point Arithmetic with Immediate (Predicated)
+	 *
+	 * <op> <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, #<const>
+	 */
+	var operation = [8]Operation{
+		ARM64_FADD, ARM64_FSUB, ARM64_FMUL, ARM64_FSUBR,
+		ARM64_FMAXNM, ARM64_FMINNM, ARM64_FMAX, ARM64_FMIN,
+	}
+	// 0.5 or 1.0, 0.5 or 2.0 and 0.0 or 1.0 encoded as IEEE754 single precision
+	var constants = [8][2]uint64{
+		{0x3f000000, 0x3f800000}, {0x3f000000, 0x3f800000}, {0x3f000000, 0x40000000}, {0x3f000000, 0x3f800000},
+		{0, 0x3f800000}, {0, 0x3f800000}, {0, 0x3f800000}, {0, 0x3f800000},
+	}
+	if ExtractBits(i.raw, 6, 4) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	opc := ExtractBits(i.raw, 16, 3)
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	rd := ExtractBits(i.raw, 0, 5)
+	i.operation = operation[opc]
+	i.setSveZReg(0, rd, esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+	i.setSveZReg(2, rd, esize)
+	i.operands[3].OpClass = FIMM32
+	i.operands[3].Immediate = constants[opc][ExtractBits(i.raw, 5, 1)]
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_fp_unary_pred() (*Instruction, error) {
+	/* SVE Floating-point Unary Operations (Predicated)
+	 *
+	 * FRINT<r> <Zd>.<T>, <Pg>/M, <Zn>.<T>
+	 * {FRECPX|FSQRT} <Zd>.<T>, <Pg>/M, <Zn>.<T>
+	 * FCVT <Zd>.<T>, <Pg>/M, <Zn>.<Tb>
+	 * FCVTX <Zd>.S, <Pg>/M, <Zn>.D
+	 * BFCVT <Zd>.H, <Pg>/M, <Zn>.S
+	 * FLOGB <Zd>.<T>, <Pg>/M, <Zn>.<T>
+	 * {SCVTF|UCVTF|FCVTZS|FCVTZU} <Zd>.<T>, <Pg>/M, <Zn>.<Tb>
+	 */
+	var frint = [8]Operation{
+		ARM64_FRINTN, ARM64_FRINTP, ARM64_FRINTM, ARM64_FRINTZ,
+		ARM64_FRINTA, ARM64_UNDEFINED, ARM64_FRINTX, ARM64_FRINTI,
+	}
+	// destination and source element sizes indexed by opc:opc2
+	var fcvt = [16][2]uint32{
+		8: {2, 4}, 9: {4, 2},
+		12: {2, 8}, 13: {8, 2}, 14: {4, 8}, 15: {8, 4},
+	}
+	var intToFp = [16][2]uint32{
+		5: {2, 2}, 6: {2, 4}, 7: {2, 8},
+		10: {4, 4},
+		12: {8, 4}, 14: {4, 8}, 15: {8, 8},
+	}
+	var fpToInt = [16][2]uint32{
+		5: {2, 2}, 6: {4, 2}, 7: {8, 2},
+		10: {4, 4},
+		12: {4, 8}, 14: {8, 4}, 15: {8, 8},
+	}
+	size := ExtractBits(i.raw, 22, 2)
+	esize := sveElementSize(size)
+	opc := ExtractBits(i.raw, 16, 5)
+	sizes := [2]uint32{esize, esize}
+	switch {
+	case size == 0 && opc != 0x0a && opc&0x19 != 0x18:
+		return nil, failedToDecodeInstruction
+	case opc&0x18 == 0:
+		i.operation = frint[opc&7]
+		if i.operation == ARM64_UNDEFINED {
+			return nil, failedToDecodeInstruction
+		}
+	case opc == 0x0c:
+		i.operation = ARM64_FRECPX
+	case opc == 0x0d:
+		i.operation = ARM64_FSQRT
+	case opc == 0x0a && size == 0:
+		i.operation = ARM64_FCVTX
+		sizes = [2]uint32{4, 8}
+	case opc == 0x0a && size == 2:
+		i.operation = ARM64_BFCVT
+		sizes = [2]uint32{2, 4}
+	case opc&0x19 == 0x18 && size == 0:
+		if ExtractBits(i.raw, 17, 2) == 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_FLOGB
+		esize = sveElementSize(ExtractBits(i.raw, 17, 2))
+		sizes = [2]uint32{esize, esize}
+	case opc&0x1c == 0x08:
+		sizes = fcvt[size<<2|opc&3]
+		if sizes[0] == 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_FCVT
+	case opc&0x18 == 0x10:
+		var operation = [2]Operation{ARM64_SCVTF, ARM64_UCVTF}
+		sizes = intToFp[size<<2|ExtractBits(i.raw, 17, 2)]
+		if sizes[0] == 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = operation[opc&1]
+	case opc&0x18 == 0x18:
+		var operation = [2]Operation{ARM64_FCVTZS, ARM64_FCVTZU}
+		sizes = fpToInt[size<<2|ExtractBits(i.raw, 17, 2)]
+		if sizes[0] == 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = operation[opc&1]
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), sizes[0])
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), sizes[1])
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_fp_compare_vectors() (*Instruction, error) {
+	/* SVE Floating-point Compare Vectors
+	 *
+	 * {FCMGE|FCMGT|FCMEQ|FCMNE|FCMUO|FACGE|FACGT} <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>
+	 */
+	var operation = [8]Operation{
+		ARM64_FCMGE, ARM64_FCMGT, ARM64_FCMEQ, ARM64_FCMNE,
+		ARM64_FCMUO, ARM64_FACGE, ARM64_UNDEFINED, ARM64_FACGT,
+	}
+	if ExtractBits(i.raw, 14, 1) != 1 {
+		return nil, failedToDecodeInstruction
+	}
+	op := ExtractBits(i.raw, 15, 1)<<2 | ExtractBits(i.raw, 13, 1)<<1 | ExtractBits(i.raw, 4, 1)
+	i.operation = operation[op]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	i.setSvePReg(0, ExtractBits(i.raw, 0, 4), esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_ZERO)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZReg(3, ExtractBits(i.raw, 16, 5), esize)
+	return i, nil
+}
+
+// sveDtype maps the four bit dtype field of contiguous and broadcast loads to
+// the memory access size (log2) and the element size in bytes
+var sveDtype = [16]struct {
+	msz   uint32
+	esize uint32
+}{
+	{0, 1}, {0, 2}, {0, 4}, {0, 8},
+	{2, 8}, {1, 2}, {1, 4}, {1, 8},
+	{1, 8}, {1, 4}, {2, 4}, {2, 8},
+	{0, 8}, {0, 4}, {0, 2}, {3, 8},
+}
+
+var sveLd1Dtype = [16]Operation{
+	ARM64_LD1B, ARM64_LD1B, ARM64_LD1B, ARM64_LD1B,
+	ARM64_LD1SW, ARM64_LD1H, ARM64_LD1H, ARM64_LD1H,
+	ARM64_LD1SH, ARM64_LD1SH, ARM64_LD1W, ARM64_LD1W,
+	ARM64_LD1SB, ARM64_LD1SB, ARM64_LD1SB, ARM64_LD1D,
+}
+
+var sveLdff1Dtype = [16]Operation{
+	ARM64_LDFF1B, ARM64_LDFF1B, ARM64_LDFF1B, ARM64_LDFF1B,
+	ARM64_LDFF1SW, ARM64_LDFF1H, ARM64_LDFF1H, ARM64_LDFF1H,
+	ARM64_LDFF1SH, ARM64_LDFF1SH, ARM64_LDFF1W, ARM64_LDFF1W,
+	ARM64_LDFF1SB, ARM64_LDFF1SB, ARM64_LDFF1SB, ARM64_LDFF1D,
+}
+
+var sveLdnf1Dtype = [16]Operation{
+	ARM64_LDNF1B, ARM64_LDNF1B, ARM64_LDNF1B, ARM64_LDNF1B,
+	ARM64_LDNF1SW, ARM64_LDNF1H, ARM64_LDNF1H, ARM64_LDNF1H,
+	ARM64_LDNF1SH, ARM64_LDNF1SH, ARM64_LDNF1W, ARM64_LDNF1W,
+	ARM64_LDNF1SB, ARM64_LDNF1SB, ARM64_LDNF1SB, ARM64_LDNF1D,
+}
+
+var sveLd1rDtype = [16]Operation{
+	ARM64_LD1RB, ARM64_LD1RB, ARM64_LD1RB, ARM64_LD1RB,
+	ARM64_LD1RSW, ARM64_LD1RH, ARM64_LD1RH, ARM64_LD1RH,
+	ARM64_LD1RSH, ARM64_LD1RSH, ARM64_LD1RW, ARM64_LD1RW,
+	ARM64_LD1RSB, ARM64_LD1RSB, ARM64_LD1RSB, ARM64_LD1RD,
+}
+
+// sveGather holds the gather load operations indexed by msz and U (unsigned)
+var sveGather = [2][4][2]Operation{
+	{
+		{ARM64_LD1SB, ARM64_LD1B},
+		{ARM64_LD1SH, ARM64_LD1H},
+		{ARM64_LD1SW, ARM64_LD1W},
+		{ARM64_UNDEFINED, ARM64_LD1D},
+	},
+	{
+		{ARM64_LDFF1SB, ARM64_LDFF1B},
+		{ARM64_LDFF1SH, ARM64_LDFF1H},
+		{ARM64_LDFF1SW, ARM64_LDFF1W},
+		{ARM64_UNDEFINED, ARM64_LDFF1D},
+	},
+}
+
+// sveLdnt1Gather is indexed by msz and U
+var sveLdnt1Gather = [4][2]Operation{
+	{ARM64_LDNT1SB, ARM64_LDNT1B},
+	{ARM64_LDNT1SH, ARM64_LDNT1H},
+	{ARM64_LDNT1SW, ARM64_LDNT1W},
+	{ARM64_UNDEFINED, ARM64_LDNT1D},
+}
+
+var sveLdN = [4][4]Operation{
+	{ARM64_LDNT1B, ARM64_LD2B, ARM64_LD3B, ARM64_LD4B},
+	{ARM64_LDNT1H, ARM64_LD2H, ARM64_LD3H, ARM64_LD4H},
+	{ARM64_LDNT1W, ARM64_LD2W, ARM64_LD3W, ARM64_LD4W},
+	{ARM64_LDNT1D, ARM64_LD2D, ARM64_LD3D, ARM64_LD4D},
+}
+
+var sveStN = [4][4]Operation{
+	{ARM64_STNT1B, ARM64_ST2B, ARM64_ST3B, ARM64_ST4B},
+	{ARM64_STNT1H, ARM64_ST2H, ARM64_ST3H, ARM64_ST4H},
+	{ARM64_STNT1W, ARM64_ST2W, ARM64_ST3W, ARM64_ST4W},
+	{ARM64_STNT1D, ARM64_ST2D, ARM64_ST3D, ARM64_ST4D},
+}
+
+var sveSt1 = [4]Operation{ARM64_ST1B, ARM64_ST1H, ARM64_ST1W, ARM64_ST1D}
+
+// sveLd1Q and sveLdNQ/sveStNQ are the SVE2.1 quadword element forms
+var sveLd1Q = [2]Operation{ARM64_LD1W, ARM64_LD1D}
+
+var sveLdNQ = [4]Operation{ARM64_UNDEFINED, ARM64_LD2Q, ARM64_LD3Q, ARM64_LD4Q}
+
+var sveStNQ = [4]Operation{ARM64_UNDEFINED, ARM64_ST2Q, ARM64_ST3Q, ARM64_ST4Q}
+
+var sveStnt1 = [4]Operation{ARM64_STNT1B, ARM64_STNT1H, ARM64_STNT1W, ARM64_STNT1D}
+
+var svePrf = [4]Operation{ARM64_PRFB, ARM64_PRFH, ARM64_PRFW, ARM64_PRFD}
+
+// setSvePrfop sets the <prfop> operand, which shares the PRFM names except
+// that the store hints are encoded from 8 rather than 16
+func (i *Instruction) setSvePrfop(idx int, prfop uint32) {
+	if prfop >= 8 && prfop < 14 {
+		prfop += 8
+	}
+	i.operands[idx].OpClass = REG
+	i.operands[idx].Reg[0] = reg(REGSET_ZR, REG_PF_BASE, int(prfop))
+}
+
+// setSveMemExtend sets a [<Xn|SP>, <Zm>.<T>, <mod>{ #<amount>}] memory operand
+// for the 32-bit unpacked/packed offset forms
+func (i *Instruction) setSveMemExtend(idx int, rn, zm, esize, xs, shift uint32) {
+	var mod = [2]ShiftType{SHIFT_UXTW, SHIFT_SXTW}
+	i.setSveMemVector(idx, rn, zm, esize, mod[xs], shift)
+}
+
+func (i *Instruction) decompose_sve_mem_gather32() (*Instruction, error) {
+	/* SVE Memory - 32-bit Gather and Unsized Contiguous
+	 *
+	 * LD1{S}{B|H|W} {<Zt>.S}, <Pg>/Z, [<Xn|SP>, <Zm>.S, <mod>{ #<amount>}]
+	 * LDFF1{S}{B|H|W} {<Zt>.S}, <Pg>/Z, [<Xn|SP>, <Zm>.S, <mod>{ #<amount>}]
+	 * LD1{S}{B|H|W} {<Zt>.S}, <Pg>/Z, [<Zn>.S{, #<imm>}]
+	 * LDFF1{S}{B|H|W} {<Zt>.S}, <Pg>/Z, [<Zn>.S{, #<imm>}]
+	 * LD1R{S}{B|H|W|D} {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>{, #<imm>}]
+	 * LDNT1{S}{B|H|W} {<Zt>.S}, <Pg>/Z, [<Zn>.S{, <Xm>}]
+	 * PRF{B|H|W|D} <prfop>, <Pg>, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * PRF{B|H|W|D} <prfop>, <Pg>, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	 * PRF{B|H|W|D} <prfop>, <Pg>, [<Xn|SP>, <Zm>.S, <mod>{ #<amount>}]
+	 * PRF{B|H|W|D} <prfop>, <Pg>, [<Zn>.S{, #<imm>}]
+	 * LDR <Pt>, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * LDR <Zt>, [<Xn|SP>{, #<imm>, MUL VL}]
+	 */
+	op0 := ExtractBits(i.raw, 23, 2)
+	op1 := ExtractBits(i.raw, 21, 2)
+	op2 := ExtractBits(i.raw, 13, 3)
+	zt := ExtractBits(i.raw, 0, 5)
+	rn := ExtractBits(i.raw, 5, 5)
+	pg := ExtractBits(i.raw, 10, 3)
+	m := ExtractBits(i.raw, 16, 5)
+	if op0 == 3 {
+		switch {
+		case op1&2 == 0 && op2 == 0 && ExtractBits(i.raw, 4, 1) == 0:
+			i.operation = ARM64_LDR
+			i.setSvePReg(0, ExtractBits(i.raw, 0, 4), 0)
+		case op1&2 == 0 && op2 == 2:
+			i.operation = ARM64_LDR
+			i.setSveZReg(0, zt, 0)
+		case op1&2 == 2 && op2&4 == 0 && ExtractBits(i.raw, 4, 1) == 0:
+			i.operation = svePrf[op2&3]
+			i.setSvePrfop(0, ExtractBits(i.raw, 0, 4))
+			i.setSvePred(1, pg, PRED_NONE)
+			imm := int64(int32(ExtractBits(i.raw, 16, 6)<<26) >> 26)
+			i.setSveMemImm(2, rn, imm, true)
+			return i, nil
+		}
+		if i.operation == ARM64_LDR {
+			imm := int64(int32((ExtractBits(i.raw, 16, 6)<<3|ExtractBits(i.raw, 10, 3))<<23) >> 23)
+			i.setSveMemImm(1, rn, imm, true)
+			return i, nil
+		}
+	}
+	if op1&2 == 2 && op2&4 == 4 {
+		dtype := op0<<2 | ExtractBits(i.raw, 13, 2)
+		i.operation = sveLd1rDtype[dtype]
+		i.setSveZList(0, zt, 1, sveDtype[dtype].esize)
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSveMemImm(2, rn, int64(ExtractBits(i.raw, 16, 6)<<sveDtype[dtype].msz), false)
+		return i, nil
+	}
+	if ExtractBits(i.raw, 4, 1) == 0 {
+		switch {
+		case op0 == 0 && op1&1 == 1 && op2&4 == 0:
+			msz := op2 & 3
+			i.operation = svePrf[msz]
+			i.setSvePrfop(0, ExtractBits(i.raw, 0, 4))
+			i.setSvePred(1, pg, PRED_NONE)
+			i.setSveMemExtend(2, rn, m, 4, ExtractBits(i.raw, 22, 1), msz)
+			return i, nil
+		case op1 == 0 && op2 == 6 && m != 31:
+			i.operation = svePrf[op0]
+			i.setSvePrfop(0, ExtractBits(i.raw, 0, 4))
+			i.setSvePred(1, pg, PRED_NONE)
+			i.setSveMemScalar(2, rn, m, op0)
+			return i, nil
+		case op1 == 0 && op2 == 7:
+			i.operation = svePrf[op0]
+			i.setSvePrfop(0, ExtractBits(i.raw, 0, 4))
+			i.setSvePred(1, pg, PRED_NONE)
+			i.setSveMemVectorImm(2, rn, 4, uint64(m<<op0))
+			return i, nil
+		}
+	}
+	if op0 == 3 {
+		return nil, failedToDecodeInstruction
+	}
+	if op2&6 == 4 && op1 == 0 {
+		i.operation = sveLdnt1Gather[op0][ExtractBits(i.raw, 13, 1)]
+		if i.operation == ARM64_LDNT1SW {
+			return nil, failedToDecodeInstruction
+		}
+		i.setSveZList(0, zt, 1, 4)
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSveMemVectorScalar(2, rn, m, 4)
+		return i, nil
+	}
+	u := ExtractBits(i.raw, 14, 1)
+	i.operation = sveGather[ExtractBits(i.raw, 13, 1)][op0][u]
+	if op0 == 2 && u == 0 {
+		return nil, failedToDecodeInstruction
+	}
+	switch {
+	case op2&4 == 0 && (op1&1 == 0 || op0 != 0):
+		i.setSveZList(0, zt, 1, 4)
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSveMemExtend(2, rn, m, 4, ExtractBits(i.raw, 22, 1), op0*(op1&1))
+	case op2&4 == 4 && op1 == 1:
+		i.setSveZList(0, zt, 1, 4)
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSveMemVectorImm(2, rn, 4, uint64(m<<op0))
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_mem_contiguous_load() (*Instruction, error) {
+	/* SVE Memory - Contiguous Load
+	 *
+	 * LD1{S}<sz> {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	 * LD1{S}<sz> {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * LDFF1{S}<sz> {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>{, <Xm>, LSL #<amount>}]
+	 * LDNF1{S}<sz> {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * LDNT1<sz> {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	 * LDNT1<sz> {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * LD{2|3|4}<sz> {<Zt1>.<T>, ...}, <Pg>/Z, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	 * LD{2|3|4}<sz> {<Zt1>.<T>, ...}, <Pg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * LD1R{Q|O}<sz> {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	 * LD1R{Q|O}<sz> {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>{, #<imm>}]
+	 * LD1{W|D} {<Zt>.Q}, <Pg>/Z, [<Xn|SP>, <Xm>, LSL #<amount>]
+	 * LD1{W|D} {<Zt>.Q}, <Pg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * LD{2|3|4}Q {<Zt1>.Q, ...}, <Pg>/Z, [<Xn|SP>, <Xm>, LSL #4]
+	 * LD{2|3|4}Q {<Zt1>.Q, ...}, <Pg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]
+	 */
+	var ld1rq = [2][4]Operation{
+		{ARM64_LD1RQB, ARM64_LD1RQH, ARM64_LD1RQW, ARM64_LD1RQD},
+		{ARM64_LD1ROB, ARM64_LD1ROH, ARM64_LD1ROW, ARM64_LD1ROD},
+	}
+	dtype := ExtractBits(i.raw, 21, 4)
+	msz := ExtractBits(i.raw, 23, 2)
+	ssz := ExtractBits(i.raw, 21, 2)
+	zt := ExtractBits(i.raw, 0, 5)
+	rn := ExtractBits(i.raw, 5, 5)
+	rm := ExtractBits(i.raw, 16, 5)
+	pg := ExtractBits(i.raw, 10, 3)
+	imm4 := int64(int32(ExtractBits(i.raw, 16, 4)<<28) >> 28)
+	switch ExtractBits(i.raw, 13, 3) {
+	case 0:
+		if ssz > 1 || rm == 31 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ld1rq[ssz][msz]
+		i.setSveZList(0, zt, 1, 1<<msz)
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSveMemScalar(2, rn, rm, msz)
+	case 1:
+		if ExtractBits(i.raw, 20, 1) == 1 {
+			if ssz != 0 || msz < 2 {
+				return nil, failedToDecodeInstruction
+			}
+			i.operation = sveLd1Q[msz&1]
+			i.setSveZList(0, zt, 1, 16)
+			i.setSvePred(1, pg, PRED_ZERO)
+			i.setSveMemImm(2, rn, imm4, true)
+			return i, nil
+		}
+		if ssz > 1 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ld1rq[ssz][msz]
+		i.setSveZList(0, zt, 1, 1<<msz)
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSveMemImm(2, rn, imm4<<(4+ssz), false)
+	case 2:
+		if rm == 31 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = sveLd1Dtype[dtype]
+		i.setSveZList(0, zt, 1, sveDtype[dtype].esize)
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSveMemScalar(2, rn, rm, sveDtype[dtype].msz)
+	case 3:
+		i.operation = sveLdff1Dtype[dtype]
+		i.setSveZList(0, zt, 1, sveDtype[dtype].esize)
+		i.setSvePred(1, pg, PRED_ZERO)
+		if rm == 31 {
+			i.setSveMemImm(2, rn, 0, false)
+		} else {
+			i.setSveMemScalar(2, rn, rm, sveDtype[dtype].msz)
+		}
+	case 5:
+		if ExtractBits(i.raw, 20, 1) == 0 {
+			i.operation = sveLd1Dtype[dtype]
+		} else {
+			i.operation = sveLdnf1Dtype[dtype]
+		}
+		i.setSveZList(0, zt, 1, sveDtype[dtype].esize)
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSveMemImm(2, rn, imm4, true)
+	case 4:
+		if rm == 31 || ssz > 1 {
+			return nil, failedToDecodeInstruction
+		}
+		if ssz == 0 {
+			if msz < 2 {
+				return nil, failedToDecodeInstruction
+			}
+			i.operation = sveLd1Q[msz&1]
+			i.setSveZList(0, zt, 1, 16)
+			i.setSvePred(1, pg, PRED_ZERO)
+			i.setSveMemScalar(2, rn, rm, msz)
+			return i, nil
+		}
+		if msz == 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = sveLdNQ[msz]
+		i.setSveZList(0, zt, msz+1, 16)
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSveMemScalar(2, rn, rm, 4)
+	case 6:
+		if rm == 31 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = sveLdN[msz][ssz]
+		i.setSveZList(0, zt, ssz+1, 1<<msz)
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSveMemScalar(2, rn, rm, msz)
+	case 7:
+		if ExtractBits(i.raw, 20, 1) != 0 {
+			if ssz != 0 || msz == 0 {
+				return nil, failedToDecodeInstruction
+			}
+			i.operation = sveLdNQ[msz]
+			i.setSveZList(0, zt, msz+1, 16)
+			i.setSvePred(1, pg, PRED_ZERO)
+			i.setSveMemImm(2, rn, imm4*int64(msz+1), true)
+			return i, nil
+		}
+		i.operation = sveLdN[msz][ssz]
+		i.setSveZList(0, zt, ssz+1, 1<<msz)
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSveMemImm(2, rn, imm4*int64(ssz+1), true)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_mem_gather64() (*Instruction, error) {
+	/* SVE Memory - 64-bit Gather
+	 *
+	 * LD1{S}<sz> {<Zt>.D}, <Pg>/Z, [<Xn|SP>, <Zm>.D, <mod>{ #<amount>}]
+	 * LD1{S}<sz> {<Zt>.D}, <Pg>/Z, [<Xn|SP>, <Zm>.D{, LSL #<amount>}]
+	 * LD1{S}<sz> {<Zt>.D}, <Pg>/Z, [<Zn>.D{, #<imm>}]
+	 * LDFF1{S}<sz> {<Zt>.D}, <Pg>/Z, [<Xn|SP>, <Zm>.D, <mod>{ #<amount>}]
+	 * LDFF1{S}<sz> {<Zt>.D}, <Pg>/Z, [<Xn|SP>, <Zm>.D{, LSL #<amount>}]
+	 * LDFF1{S}<sz> {<Zt>.D}, <Pg>/Z, [<Zn>.D{, #<imm>}]
+	 * LDNT1{S}<sz> {<Zt>.D}, <Pg>/Z, [<Zn>.D{, <Xm>}]
+	 * LD1Q {<Zt>.Q}, <Pg>/Z, [<Zn>.D{, <Xm>}]
+	 * PRF{B|H|W|D} <prfop>, <Pg>, [<Xn|SP>, <Zm>.D, <mod>{ #<amount>}]
+	 * PRF{B|H|W|D} <prfop>, <Pg>, [<Xn|SP>, <Zm>.D{, LSL #<amount>}]
+	 * PRF{B|H|W|D} <prfop>, <Pg>, [<Zn>.D{, #<imm>}]
+	 */
+	msz := ExtractBits(i.raw, 23, 2)
+	op1 := ExtractBits(i.raw, 21, 2)
+	op2 := ExtractBits(i.raw, 13, 3)
+	zt := ExtractBits(i.raw, 0, 5)
+	rn := ExtractBits(i.raw, 5, 5)
+	pg := ExtractBits(i.raw, 10, 3)
+	m := ExtractBits(i.raw, 16, 5)
+	if ExtractBits(i.raw, 4, 1) == 0 {
+		switch {
+		case msz == 0 && op1&1 == 1 && op2&4 == 0:
+			i.operation = svePrf[op2&3]
+			i.setSvePrfop(0, ExtractBits(i.raw, 0, 4))
+			i.setSvePred(1, pg, PRED_NONE)
+			i.setSveMemExtend(2, rn, m, 8, ExtractBits(i.raw, 22, 1), op2&3)
+			return i, nil
+		case msz == 0 && op1 == 3 && op2&4 == 4:
+			i.operation = svePrf[op2&3]
+			i.setSvePrfop(0, ExtractBits(i.raw, 0, 4))
+			i.setSvePred(1, pg, PRED_NONE)
+			i.setSveMemVector(2, rn, m, 8, SHIFT_LSL, op2&3)
+			if op2&3 == 0 {
+				i.operands[2].ShiftType = SHIFT_NONE
+			}
+			return i, nil
+		case op1 == 0 && op2 == 7:
+			i.operation = svePrf[msz]
+			i.setSvePrfop(0, ExtractBits(i.raw, 0, 4))
+			i.setSvePred(1, pg, PRED_NONE)
+			i.setSveMemVectorImm(2, rn, 8, uint64(m<<msz))
+			return i, nil
+		}
+	}
+	u := ExtractBits(i.raw, 14, 1)
+	if op1 == 0 && op2 == 5 && msz == 0 {
+		i.operation = ARM64_LD1Q
+		i.setSveZList(0, zt, 1, 16)
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSveMemVectorScalar(2, rn, m, 8)
+		return i, nil
+	}
+	if op1 == 0 && op2&5 == 4 {
+		i.operation = sveLdnt1Gather[msz][u]
+		if i.operation == ARM64_UNDEFINED {
+			return nil, failedToDecodeInstruction
+		}
+		i.setSveZList(0, zt, 1, 8)
+		i.setSvePred(1, pg, PRED_ZERO)
+		i.setSveMemVectorScalar(2, rn, m, 8)
+		return i, nil
+	}
+	i.operation = sveGather[ExtractBits(i.raw, 13, 1)][msz][u]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	scaled := op1 & 1
+	if scaled == 1 && msz == 0 && !(op1 == 1 && op2&4 == 4) {
+		return nil, failedToDecodeInstruction
+	}
+	i.setSveZList(0, zt, 1, 8)
+	i.setSvePred(1, pg, PRED_ZERO)
+	switch {
+	case op2&4 == 0:
+		i.setSveMemExtend(2, rn, m, 8, ExtractBits(i.raw, 22, 1), msz*scaled)
+	case op1&2 == 2:
+		i.setSveMemVector(2, rn, m, 8, SHIFT_LSL, msz*scaled)
+		if scaled == 0 {
+			i.operands[2].ShiftType = SHIFT_NONE
+		}
+	case op1 == 1:
+		i.setSveMemVectorImm(2, rn, 8, uint64(m<<msz))
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_mem_store() (*Instruction, error) {
+	/* SVE Memory - Store
+	 *
+	 * ST1<sz> {<Zt>.<T>}, <Pg>, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	 * ST1<sz> {<Zt>.<T>}, <Pg>, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * ST1<sz> {<Zt>.<T>}, <Pg>, [<Xn|SP>, <Zm>.<T>, <mod>{ #<amount>}]
+	 * ST1<sz> {<Zt>.D}, <Pg>, [<Xn|SP>, <Zm>.D{, LSL #<amount>}]
+	 * ST1<sz> {<Zt>.<T>}, <Pg>, [<Zn>.<T>{, #<imm>}]
+	 * STNT1<sz> {<Zt>.<T>}, <Pg>, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	 * STNT1<sz> {<Zt>.<T>}, <Pg>, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * STNT1<sz> {<Zt>.<T>}, <Pg>, [<Zn>.<T>{, <Xm>}]
+	 * ST{2|3|4}<sz> {<Zt1>.<T>, ...}, <Pg>, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	 * ST{2|3|4}<sz> {<Zt1>.<T>, ...}, <Pg>, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * ST1{W|D} {<Zt>.Q}, <Pg>, [<Xn|SP>, <Xm>, LSL #<amount>]
+	 * ST1{W|D} {<Zt>.Q}, <Pg>, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * ST1Q {<Zt>.Q}, <Pg>, [<Zn>.D{, <Xm>}]
+	 * ST{2|3|4}Q {<Zt1>.Q, ...}, <Pg>, [<Xn|SP>, <Xm>, LSL #4]
+	 * ST{2|3|4}Q {<Zt1>.Q, ...}, <Pg>, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * STR <Pt>, [<Xn|SP>{, #<imm>, MUL VL}]
+	 * STR <Zt>, [<Xn|SP>{, #<imm>, MUL VL}]
+	 */
+	msz := ExtractBits(i.raw, 23, 2)
+	op1 := ExtractBits(i.raw, 21, 2)
+	zt := ExtractBits(i.raw, 0, 5)
+	rn := ExtractBits(i.raw, 5, 5)
+	rm := ExtractBits(i.raw, 16, 5)
+	pg := ExtractBits(i.raw, 10, 3)
+	imm4 := int64(int32(ExtractBits(i.raw, 16, 4)<<28) >> 28)
+	imm9 := int64(int32((ExtractBits(i.raw, 16, 6)<<3|ExtractBits(i.raw, 10, 3))<<23) >> 23)
+	switch ExtractBits(i.raw, 13, 3) {
+	case 0:
+		if ExtractBits(i.raw, 24, 1) == 0 {
+			nreg := ExtractBits(i.raw, 22, 2)
+			if nreg == 0 || (ExtractBits(i.raw, 21, 1) == 0 && ExtractBits(i.raw, 20, 1) != 0) {
+				return nil, failedToDecodeInstruction
+			}
+			i.operation = sveStNQ[nreg]
+			i.setSveZList(0, zt, nreg+1, 16)
+			i.setSvePred(1, pg, PRED_NONE)
+			if ExtractBits(i.raw, 21, 1) == 1 {
+				if rm == 31 {
+					return nil, failedToDecodeInstruction
+				}
+				i.setSveMemScalar(2, rn, rm, 4)
+			} else {
+				i.setSveMemImm(2, rn, imm4*int64(nreg+1), true)
+			}
+			return i, nil
+		}
+		if msz != 3 || op1&2 != 0 || ExtractBits(i.raw, 4, 1) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_STR
+		i.setSvePReg(0, ExtractBits(i.raw, 0, 4), 0)
+		i.setSveMemImm(1, rn, imm9, true)
+	case 1:
+		if op1 == 1 && msz == 0 {
+			i.operation = ARM64_ST1Q
+			i.setSveZList(0, zt, 1, 16)
+			i.setSvePred(1, pg, PRED_NONE)
+			i.setSveMemVectorScalar(2, rn, rm, 8)
+			return i, nil
+		}
+		if op1&1 != 0 || (op1 == 2 && msz == 3) {
+			return nil, failedToDecodeInstruction
+		}
+		esize := uint32(8)
+		if op1 == 2 {
+			esize = 4
+		}
+		i.operation = sveStnt1[msz]
+		i.setSveZList(0, zt, 1, esize)
+		i.setSvePred(1, pg, PRED_NONE)
+		i.setSveMemVectorScalar(2, rn, rm, esize)
+	case 2:
+		if msz == 3 && op1&2 == 0 {
+			i.operation = ARM64_STR
+			i.setSveZReg(0, zt, 0)
+			i.setSveMemImm(1, rn, imm9, true)
+			return i, nil
+		}
+		if rm == 31 {
+			return nil, failedToDecodeInstruction
+		}
+		if (msz == 2 && op1 == 0) || (msz == 3 && op1 == 2) {
+			i.operation = sveSt1[msz]
+			i.setSveZList(0, zt, 1, 16)
+			i.setSvePred(1, pg, PRED_NONE)
+			i.setSveMemScalar(2, rn, rm, msz)
+			return i, nil
+		}
+		if op1 < msz {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = sveSt1[msz]
+		i.setSveZList(0, zt, 1, 1<<op1)
+		i.setSvePred(1, pg, PRED_NONE)
+		i.setSveMemScalar(2, rn, rm, msz)
+	case 3:
+		if rm == 31 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = sveStN[msz][op1]
+		i.setSveZList(0, zt, op1+1, 1<<msz)
+		i.setSvePred(1, pg, PRED_NONE)
+		i.setSveMemScalar(2, rn, rm, msz)
+	case 4:
+		fallthrough
+	case 6:
+		esize := uint32(8)
+		if op1&2 == 2 {
+			esize = 4
+		}
+		scaled := op1 & 1
+		if (esize == 4 && msz == 3) || (scaled == 1 && msz == 0) {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = sveSt1[msz]
+		i.setSveZList(0, zt, 1, esize)
+		i.setSvePred(1, pg, PRED_NONE)
+		i.setSveMemExtend(2, rn, rm, esize, ExtractBits(i.raw, 14, 1), msz*scaled)
+	case 5:
+		i.operation = sveSt1[msz]
+		switch op1 {
+		case 0:
+			fallthrough
+		case 1:
+			if op1 == 1 && msz == 0 {
+				return nil, failedToDecodeInstruction
+			}
+			i.setSveZList(0, zt, 1, 8)
+			i.setSvePred(1, pg, PRED_NONE)
+			i.setSveMemVector(2, rn, rm, 8, SHIFT_LSL, msz*op1)
+			if op1 == 0 {
+				i.operands[2].ShiftType = SHIFT_NONE
+			}
+		case 2:
+			i.setSveZList(0, zt, 1, 8)
+			i.setSvePred(1, pg, PRED_NONE)
+			i.setSveMemVectorImm(2, rn, 8, uint64(rm<<msz))
+		case 3:
+			if msz == 3 {
+				return nil, failedToDecodeInstruction
+			}
+			i.setSveZList(0, zt, 1, 4)
+			i.setSvePred(1, pg, PRED_NONE)
+			i.setSveMemVectorImm(2, rn, 4, uint64(rm<<msz))
+		}
+	case 7:
+		if ExtractBits(i.raw, 20, 1) == 0 {
+			esize := uint32(1 << op1)
+			if (msz == 2 && op1 == 0) || (msz == 3 && op1 == 2) {
+				esize = 16
+			} else if op1 < msz {
+				return nil, failedToDecodeInstruction
+			}
+			i.operation = sveSt1[msz]
+			i.setSveZList(0, zt, 1, esize)
+			i.setSvePred(1, pg, PRED_NONE)
+			i.setSveMemImm(2, rn, imm4, true)
+			return i, nil
+		}
+		i.operation = sveStN[msz][op1]
+		i.setSveZList(0, zt, op1+1, 1<<msz)
+		i.setSvePred(1, pg, PRED_NONE)
+		i.setSveMemImm(2, rn, imm4*int64(op1+1), true)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
@@ -0,0 +1,1071 @@
+package arm64
+
+//---------------------------------------------
+// C4.1.x SVE2 encodings
+//---------------------------------------------
+
+func (i *Instruction) decompose_sve_int_multiply_add() (*Instruction, error) {
+	if ExtractBits(i.raw, 24, 1) == 1 {
+		return i.decompose_sve2_widening()
+	}
+	if ExtractBits(i.raw, 21, 1) == 1 {
+		return i.decompose_sve_int_mla_indexed()
+	}
+	switch ExtractBits(i.raw, 13, 3) {
+	case 4:
+		return i.decompose_sve2_int_pred_arith()
+	case 5:
+		return i.decompose_sve2_int_pred_misc()
+	case 6:
+		return i.decompose_sve2_clamp_dot()
+	case 7:
+		return i.decompose_sve2_permute_quadwords()
+	}
+	if ExtractBits(i.raw, 11, 5) == 0 {
+		return i.decompose_sve_int_dot()
+	}
+	return i.decompose_sve2_int_mla_unpred()
+}
+
+func (i *Instruction) decompose_sve2_int_mla_unpred() (*Instruction, error) {
+	/* SVE2 Integer Multiply-Add - Unpredicated
+	 *
+	 * {SQDMLALBT|SQDMLSLBT} <Zda>.<T>, <Zn>.<Tb>, <Zm>.<Tb>
+	 * {CMLA|SQRDCMLAH} <Zda>.<T>, <Zn>.<T>, <Zm>.<T>, #<const>
+	 * CDOT <Zda>.<T>, <Zn>.<Tb>, <Zm>.<Tb>, #<const>
+	 * {S|U}{MLAL|MLSL}{B|T} <Zda>.<T>, <Zn>.<Tb>, <Zm>.<Tb>
+	 * SQDML{A|S}L{B|T} <Zda>.<T>, <Zn>.<Tb>, <Zm>.<Tb>
+	 * {SQRDMLAH|SQRDMLSH} <Zda>.<T>, <Zn>.<T>, <Zm>.<T>
+	 * USDOT <Zda>.S, <Zn>.B, <Zm>.B
+	 */
+	var mlal = [8]Operation{
+		ARM64_SMLALB, ARM64_SMLALT, ARM64_UMLALB, ARM64_UMLALT,
+		ARM64_SMLSLB, ARM64_SMLSLT, ARM64_UMLSLB, ARM64_UMLSLT,
+	}
+	var sqdmlal = [4]Operation{ARM64_SQDMLALB, ARM64_SQDMLALT, ARM64_SQDMLSLB, ARM64_SQDMLSLT}
+	size := ExtractBits(i.raw, 22, 2)
+	op := ExtractBits(i.raw, 10, 5)
+	esize := sveElementSize(size)
+	zda := ExtractBits(i.raw, 0, 5)
+	zn := ExtractBits(i.raw, 5, 5)
+	zm := ExtractBits(i.raw, 16, 5)
+	switch {
+	case op == 2 || op == 3:
+		if size == 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = [2]Operation{ARM64_SQDMLALBT, ARM64_SQDMLSLBT}[op&1]
+		i.setSveZReg(0, zda, esize)
+		i.setSveZReg(1, zn, esize/2)
+		i.setSveZReg(2, zm, esize/2)
+	case op>>2 == 1:
+		if size < 2 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_CDOT
+		i.setSveZReg(0, zda, esize)
+		i.setSveZReg(1, zn, esize/4)
+		i.setSveZReg(2, zm, esize/4)
+		i.setSveImm(3, int64(ExtractBits(i.raw, 10, 2)*90))
+	case op>>3 == 1:
+		i.operation = [2]Operation{ARM64_CMLA, ARM64_SQRDCMLAH}[ExtractBits(i.raw, 12, 1)]
+		i.setSveZReg(0, zda, esize)
+		i.setSveZReg(1, zn, esize)
+		i.setSveZReg(2, zm, esize)
+		i.setSveImm(3, int64(ExtractBits(i.raw, 10, 2)*90))
+	case op>>3 == 2:
+		if size == 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = mlal[op&7]
+		i.setSveZReg(0, zda, esize)
+		i.setSveZReg(1, zn, esize/2)
+		i.setSveZReg(2, zm, esize/2)
+	case op>>2 == 6:
+		if size == 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = sqdmlal[op&3]
+		i.setSveZReg(0, zda, esize)
+		i.setSveZReg(1, zn, esize/2)
+		i.setSveZReg(2, zm, esize/2)
+	case op == 0x1c || op == 0x1d:
+		i.operation = [2]Operation{ARM64_SQRDMLAH, ARM64_SQRDMLSH}[op&1]
+		i.setSveZReg(0, zda, esize)
+		i.setSveZReg(1, zn, esize)
+		i.setSveZReg(2, zm, esize)
+	case op == 0x1e:
+		if size != 2 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_USDOT
+		i.setSveZReg(0, zda, 4)
+		i.setSveZReg(1, zn, 1)
+		i.setSveZReg(2, zm, 1)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve_int_mla_indexed() (*Instruction, error) {
+	/* SVE Integer Multiply-Add - Indexed
+	 *
+	 * {SDOT|UDOT|USDOT|SUDOT} <Zda>.<T>, <Zn>.<Tb>, <Zm>.<Tb>[<imm>]
+	 * {MLA|MLS|SQRDMLAH|SQRDMLSH} <Zda>.<T>, <Zn>.<T>, <Zm>.<T>[<imm>]
+	 * {SQDMULH|SQRDMULH|MUL} <Zd>.<T>, <Zn>.<T>, <Zm>.<T>[<imm>]
+	 * SQDML{A|S}L{B|T} <Zda>.<T>, <Zn>.<Tb>, <Zm>.<Tb>[<imm>]
+	 * {S|U}{MLAL|MLSL|MULL}{B|T} <Zda>.<T>, <Zn>.<Tb>, <Zm>.<Tb>[<imm>]
+	 * SQDMULL{B|T} <Zd>.<T>, <Zn>.<Tb>, <Zm>.<Tb>[<imm>]
+	 * CDOT <Zda>.<T>, <Zn>.<Tb>, <Zm>.<Tb>[<imm>], #<const>
+	 * {CMLA|SQRDCMLAH} <Zda>.<T>, <Zn>.<T>, <Zm>.<T>[<imm>], #<const>
+	 */
+	var long = [16]Operation{
+		ARM64_SMLALB, ARM64_SMLALT, ARM64_UMLALB, ARM64_UMLALT,
+		ARM64_SMLSLB, ARM64_SMLSLT, ARM64_UMLSLB, ARM64_UMLSLT,
+		ARM64_SMULLB, ARM64_SMULLT, ARM64_UMULLB, ARM64_UMULLT,
+		ARM64_SQDMULLB, ARM64_SQDMULLT, ARM64_UNDEFINED, ARM64_UNDEFINED,
+	}
+	var sqdmlal = [4]Operation{ARM64_SQDMLALB, ARM64_SQDMLALT, ARM64_SQDMLSLB, ARM64_SQDMLSLT}
+	size := ExtractBits(i.raw, 22, 2)
+	op := ExtractBits(i.raw, 10, 6)
+	zda := ExtractBits(i.raw, 0, 5)
+	zn := ExtractBits(i.raw, 5, 5)
+	switch {
+	case op>>1 == 0:
+		return i.decompose_sve_int_dot()
+	case op>>1 == 1 || op>>1 == 2:
+		var operation = [4]Operation{ARM64_MLA, ARM64_MLS, ARM64_SQRDMLAH, ARM64_SQRDMLSH}
+		i.operation = operation[op-2]
+		i.setSveFpIndexedOperands()
+	case op>>1 == 3:
+		if size != 2 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = [2]Operation{ARM64_USDOT, ARM64_SUDOT}[op&1]
+		i.setSveZReg(0, zda, 4)
+		i.setSveZReg(1, zn, 1)
+		i.setSveZRegIndexed(2, ExtractBits(i.raw, 16, 3), 1, ExtractBits(i.raw, 19, 2))
+	case op>>2 == 2 || op>>2 == 3:
+		if size < 2 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = sqdmlal[(op>>1)&2|op&1]
+		i.setSveLongIndexedOperands(size)
+	case op>>2 == 4:
+		if size < 2 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_CDOT
+		esize := sveElementSize(size)
+		i.setSveZReg(0, zda, esize)
+		i.setSveZReg(1, zn, esize/4)
+		if size == 2 {
+			i.setSveZRegIndexed(2, ExtractBits(i.raw, 16, 3), 1, ExtractBits(i.raw, 19, 2))
+		} else {
+			i.setSveZRegIndexed(2, ExtractBits(i.raw, 16, 4), 2, ExtractBits(i.raw, 20, 1))
+		}
+		i.setSveImm(3, int64(ExtractBits(i.raw, 10, 2)*90))
+	case op>>3 == 3:
+		var esize uint32
+		switch size {
+		case 2:
+			esize = 2
+			i.setSveZRegIndexed(2, ExtractBits(i.raw, 16, 3), esize, ExtractBits(i.raw, 19, 2))
+		case 3:
+			esize = 4
+			i.setSveZRegIndexed(2, ExtractBits(i.raw, 16, 4), esize, ExtractBits(i.raw, 20, 1))
+		default:
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = [2]Operation{ARM64_CMLA, ARM64_SQRDCMLAH}[ExtractBits(i.raw, 12, 1)]
+		i.setSveZReg(0, zda, esize)
+		i.setSveZReg(1, zn, esize)
+		i.setSveImm(3, int64(ExtractBits(i.raw, 10, 2)*90))
+	case op>>5 == 1 && op>>2 != 15:
+		if size < 2 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = long[(op>>1)&0xe|op&1]
+		if i.operation == ARM64_UNDEFINED {
+			return nil, failedToDecodeInstruction
+		}
+		i.setSveLongIndexedOperands(size)
+	case op>>2 == 15:
+		var operation = [4]Operation{ARM64_SQDMULH, ARM64_SQRDMULH, ARM64_MUL, ARM64_UNDEFINED}
+		i.operation = operation[op&3]
+		if i.operation == ARM64_UNDEFINED {
+			return nil, failedToDecodeInstruction
+		}
+		i.setSveFpIndexedOperands()
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+// setSveLongIndexedOperands sets <Zda>.<T>, <Zn>.<Tb>, <Zm>.<Tb>[<imm>] for the widening indexed forms
+func (i *Instruction) setSveLongIndexedOperands(size uint32) {
+	esize := sveElementSize(size)
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize/2)
+	if size == 2 {
+		index := ExtractBits(i.raw, 19, 2)<<1 | ExtractBits(i.raw, 11, 1)
+		i.setSveZRegIndexed(2, ExtractBits(i.raw, 16, 3), 2, index)
+	} else {
+		index := ExtractBits(i.raw, 20, 1)<<1 | ExtractBits(i.raw, 11, 1)
+		i.setSveZRegIndexed(2, ExtractBits(i.raw, 16, 4), 4, index)
+	}
+}
+
+func (i *Instruction) decompose_sve2_int_pred_arith() (*Instruction, error) {
+	/* SVE2 Integer Binary Arithmetic - Predicated
+	 *
+	 * <op> <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, <Zm>.<T>
+	 */
+	var operation = [32]Operation{
+		ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_SRSHL, ARM64_URSHL,
+		ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_SRSHLR, ARM64_URSHLR,
+		ARM64_SQSHL, ARM64_UQSHL, ARM64_SQRSHL, ARM64_UQRSHL,
+		ARM64_SQSHLR, ARM64_UQSHLR, ARM64_SQRSHLR, ARM64_UQRSHLR,
+		ARM64_SHADD, ARM64_UHADD, ARM64_SHSUB, ARM64_UHSUB,
+		ARM64_SRHADD, ARM64_URHADD, ARM64_SHSUBR, ARM64_UHSUBR,
+		ARM64_SQADD, ARM64_UQADD, ARM64_SQSUB, ARM64_UQSUB,
+		ARM64_SUQADD, ARM64_USQADD, ARM64_SQSUBR, ARM64_UQSUBR,
+	}
+	i.operation = operation[ExtractBits(i.raw, 16, 5)]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	rdn := ExtractBits(i.raw, 0, 5)
+	i.setSveZReg(0, rdn, esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+	i.setSveZReg(2, rdn, esize)
+	i.setSveZReg(3, ExtractBits(i.raw, 5, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_int_pred_misc() (*Instruction, error) {
+	/* SVE2 Integer Unary and Pairwise Arithmetic - Predicated
+	 *
+	 * {URECPE|URSQRTE} <Zd>.S, <Pg>/M, <Zn>.S
+	 * {SADALP|UADALP} <Zda>.<T>, <Pg>/M, <Zn>.<Tb>
+	 * {SQABS|SQNEG} <Zd>.<T>, <Pg>/M, <Zn>.<T>
+	 * {ADDP|SMAXP|UMAXP|SMINP|UMINP} <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, <Zm>.<T>
+	 */
+	size := ExtractBits(i.raw, 22, 2)
+	esize := sveElementSize(size)
+	rd := ExtractBits(i.raw, 0, 5)
+	pg := ExtractBits(i.raw, 10, 3)
+	zn := ExtractBits(i.raw, 5, 5)
+	switch opc := ExtractBits(i.raw, 16, 5); opc {
+	case 0:
+		fallthrough
+	case 1:
+		if size != 2 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = [2]Operation{ARM64_URECPE, ARM64_URSQRTE}[opc]
+		i.setSveZReg(0, rd, esize)
+		i.setSvePred(1, pg, PRED_MERGE)
+		i.setSveZReg(2, zn, esize)
+	case 4:
+		fallthrough
+	case 5:
+		if size == 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = [2]Operation{ARM64_SADALP, ARM64_UADALP}[opc&1]
+		i.setSveZReg(0, rd, esize)
+		i.setSvePred(1, pg, PRED_MERGE)
+		i.setSveZReg(2, zn, esize/2)
+	case 8:
+		fallthrough
+	case 9:
+		i.operation = [2]Operation{ARM64_SQABS, ARM64_SQNEG}[opc&1]
+		i.setSveZReg(0, rd, esize)
+		i.setSvePred(1, pg, PRED_MERGE)
+		i.setSveZReg(2, zn, esize)
+	case 0x11, 0x14, 0x15, 0x16, 0x17:
+		var operation = [8]Operation{
+			ARM64_UNDEFINED, ARM64_ADDP, ARM64_UNDEFINED, ARM64_UNDEFINED,
+			ARM64_SMAXP, ARM64_UMAXP, ARM64_SMINP, ARM64_UMINP,
+		}
+		i.operation = operation[opc&7]
+		i.setSveZReg(0, rd, esize)
+		i.setSvePred(1, pg, PRED_MERGE)
+		i.setSveZReg(2, rd, esize)
+		i.setSveZReg(3, zn, esize)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_clamp_dot() (*Instruction, error) {
+	/* SVE2 Integer Clamp and Two-way Dot Product
+	 *
+	 * {SCLAMP|UCLAMP} <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	 * {SDOT|UDOT} <Zda>.S, <Zn>.H, <Zm>.H
+	 * {SDOT|UDOT} <Zda>.S, <Zn>.H, <Zm>.H[<imm>]
+	 */
+	size := ExtractBits(i.raw, 22, 2)
+	zd := ExtractBits(i.raw, 0, 5)
+	zn := ExtractBits(i.raw, 5, 5)
+	switch ExtractBits(i.raw, 11, 2) {
+	case 0:
+		esize := sveElementSize(size)
+		i.operation = [2]Operation{ARM64_SCLAMP, ARM64_UCLAMP}[ExtractBits(i.raw, 10, 1)]
+		i.setSveZReg(0, zd, esize)
+		i.setSveZReg(1, zn, esize)
+		i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+	case 1:
+		i.operation = [2]Operation{ARM64_SDOT, ARM64_UDOT}[ExtractBits(i.raw, 10, 1)]
+		i.setSveZReg(0, zd, 4)
+		i.setSveZReg(1, zn, 2)
+		switch size {
+		case 0:
+			i.setSveZReg(2, ExtractBits(i.raw, 16, 5), 2)
+		case 2:
+			i.setSveZRegIndexed(2, ExtractBits(i.raw, 16, 3), 2, ExtractBits(i.raw, 19, 2))
+		default:
+			return nil, failedToDecodeInstruction
+		}
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_permute_quadwords() (*Instruction, error) {
+	/* SVE2 Permute Vector Segments
+	 *
+	 * {ZIPQ1|ZIPQ2|UZPQ1|UZPQ2} <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	 * TBLQ <Zd>.<T>, { <Zn>.<T> }, <Zm>.<T>
+	 */
+	var operation = [8]Operation{
+		ARM64_ZIPQ1, ARM64_ZIPQ2, ARM64_UZPQ1, ARM64_UZPQ2,
+		ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_TBLQ, ARM64_UNDEFINED,
+	}
+	i.operation = operation[ExtractBits(i.raw, 10, 3)]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	if i.operation == ARM64_TBLQ {
+		i.setSveZList(1, ExtractBits(i.raw, 5, 5), 1, esize)
+	} else {
+		i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	}
+	i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_int_mul_unpred() (*Instruction, error) {
+	/* SVE2 Integer Multiply - Unpredicated
+	 *
+	 * {MUL|SMULH|UMULH|SQDMULH|SQRDMULH} <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	 * PMUL <Zd>.B, <Zn>.B, <Zm>.B
+	 */
+	var operation = [8]Operation{
+		ARM64_MUL, ARM64_PMUL, ARM64_SMULH, ARM64_UMULH,
+		ARM64_SQDMULH, ARM64_SQRDMULH, ARM64_UNDEFINED, ARM64_UNDEFINED,
+	}
+	size := ExtractBits(i.raw, 22, 2)
+	i.operation = operation[ExtractBits(i.raw, 10, 3)]
+	if i.operation == ARM64_UNDEFINED || (i.operation == ARM64_PMUL && size != 0) {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(size)
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_xar() (*Instruction, error) {
+	/* SVE2 Bitwise Exclusive-OR and Rotate
+	 *
+	 * XAR <Zdn>.<T>, <Zdn>.<T>, <Zm>.<T>, #<const>
+	 */
+	tsz := ExtractBits(i.raw, 22, 2)<<2 | ExtractBits(i.raw, 19, 2)
+	esize := sveTszElementSize(tsz)
+	if esize == 0 {
+		return nil, failedToDecodeInstruction
+	}
+	imm := tsz<<3 | ExtractBits(i.raw, 16, 3)
+	i.operation = ARM64_XAR
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveImm(3, int64(2*esize*8-imm))
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_bitwise_ternary() (*Instruction, error) {
+	/* SVE2 Bitwise Ternary Operations
+	 *
+	 * <op> <Zdn>.D, <Zdn>.D, <Zm>.D, <Zk>.D
+	 */
+	var operation = [2][4]Operation{
+		{ARM64_EOR3, ARM64_BCAX, ARM64_UNDEFINED, ARM64_UNDEFINED},
+		{ARM64_BSL, ARM64_BSL1N, ARM64_BSL2N, ARM64_NBSL},
+	}
+	i.operation = operation[ExtractBits(i.raw, 10, 1)][ExtractBits(i.raw, 22, 2)]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), 8)
+	i.setSveZReg(1, ExtractBits(i.raw, 0, 5), 8)
+	i.setSveZReg(2, ExtractBits(i.raw, 16, 5), 8)
+	i.setSveZReg(3, ExtractBits(i.raw, 5, 5), 8)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_widening() (*Instruction, error) {
+	if ExtractBits(i.raw, 21, 1) == 0 {
+		switch ExtractBits(i.raw, 13, 3) {
+		case 0:
+			fallthrough
+		case 1:
+			fallthrough
+		case 2:
+			fallthrough
+		case 3:
+			return i.decompose_sve2_int_long()
+		case 4:
+			return i.decompose_sve2_misc()
+		case 5:
+			if ExtractBits(i.raw, 12, 1) == 0 {
+				return i.decompose_sve2_shift_left_long()
+			}
+			return i.decompose_sve2_bitperm()
+		case 6:
+			if ExtractBits(i.raw, 12, 1) == 0 {
+				return i.decompose_sve2_abal()
+			}
+			if ExtractBits(i.raw, 11, 1) == 0 {
+				return i.decompose_sve2_add_carry()
+			}
+			return i.decompose_sve2_complex_add()
+		case 7:
+			return i.decompose_sve2_shift_accumulate()
+		}
+	}
+	switch ExtractBits(i.raw, 13, 3) {
+	case 0:
+		fallthrough
+	case 1:
+		return i.decompose_sve2_narrow_shift()
+	case 2:
+		return i.decompose_sve2_narrow_extract()
+	case 3:
+		return i.decompose_sve2_add_sub_high()
+	case 4:
+		return i.decompose_sve2_match()
+	case 5:
+		fallthrough
+	case 6:
+		return i.decompose_sve2_histogram()
+	case 7:
+		return i.decompose_sve2_crypto()
+	}
+	return nil, failedToDecodeInstruction
+}
+
+func (i *Instruction) decompose_sve2_int_long() (*Instruction, error) {
+	/* SVE2 Integer Add/Subtract/Multiply Long and Wide
+	 *
+	 * <op> <Zd>.<T>, <Zn>.<Tb>, <Zm>.<Tb>
+	 * <op> <Zd>.<T>, <Zn>.<T>, <Zm>.<Tb>
+	 */
+	var operation = [32]Operation{
+		ARM64_SADDLB, ARM64_SADDLT, ARM64_UADDLB, ARM64_UADDLT,
+		ARM64_SSUBLB, ARM64_SSUBLT, ARM64_USUBLB, ARM64_USUBLT,
+		ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+		ARM64_SABDLB, ARM64_SABDLT, ARM64_UABDLB, ARM64_UABDLT,
+		ARM64_SADDWB, ARM64_SADDWT, ARM64_UADDWB, ARM64_UADDWT,
+		ARM64_SSUBWB, ARM64_SSUBWT, ARM64_USUBWB, ARM64_USUBWT,
+		ARM64_SQDMULLB, ARM64_SQDMULLT, ARM64_PMULLB, ARM64_PMULLT,
+		ARM64_SMULLB, ARM64_SMULLT, ARM64_UMULLB, ARM64_UMULLT,
+	}
+	op := ExtractBits(i.raw, 10, 5)
+	size := ExtractBits(i.raw, 22, 2)
+	i.operation = operation[op]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(size)
+	if i.operation == ARM64_PMULLB || i.operation == ARM64_PMULLT {
+		switch size {
+		case 0:
+			esize = 16
+		case 2:
+			return nil, failedToDecodeInstruction
+		}
+	} else if size == 0 {
+		return nil, failedToDecodeInstruction
+	}
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	if op>>2 == 4 || op>>2 == 5 {
+		i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	} else {
+		i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize/2)
+	}
+	i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize/2)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_misc() (*Instruction, error) {
+	/* SVE2 Misc
+	 *
+	 * {SADDLBT|SSUBLBT|SSUBLTB} <Zd>.<T>, <Zn>.<Tb>, <Zm>.<Tb>
+	 * {EORBT|EORTB} <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	 * {SMMLA|USMMLA|UMMLA} <Zda>.S, <Zn>.B, <Zm>.B
+	 */
+	var mmla = [4]Operation{ARM64_SMMLA, ARM64_UNDEFINED, ARM64_USMMLA, ARM64_UMMLA}
+	size := ExtractBits(i.raw, 22, 2)
+	esize := sveElementSize(size)
+	zd := ExtractBits(i.raw, 0, 5)
+	zn := ExtractBits(i.raw, 5, 5)
+	zm := ExtractBits(i.raw, 16, 5)
+	switch op := ExtractBits(i.raw, 10, 3); op {
+	case 0, 2, 3:
+		if size == 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = [4]Operation{ARM64_SADDLBT, ARM64_UNDEFINED, ARM64_SSUBLBT, ARM64_SSUBLTB}[op]
+		i.setSveZReg(0, zd, esize)
+		i.setSveZReg(1, zn, esize/2)
+		i.setSveZReg(2, zm, esize/2)
+	case 4:
+		fallthrough
+	case 5:
+		i.operation = [2]Operation{ARM64_EORBT, ARM64_EORTB}[op&1]
+		i.setSveZReg(0, zd, esize)
+		i.setSveZReg(1, zn, esize)
+		i.setSveZReg(2, zm, esize)
+	case 6:
+		i.operation = mmla[size]
+		if i.operation == ARM64_UNDEFINED {
+			return nil, failedToDecodeInstruction
+		}
+		i.setSveZReg(0, zd, 4)
+		i.setSveZReg(1, zn, 1)
+		i.setSveZReg(2, zm, 1)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_shift_left_long() (*Instruction, error) {
+	/* SVE2 Bitwise Shift Left Long
+	 *
+	 * {SSHLLB|SSHLLT|USHLLB|USHLLT} <Zd>.<T>, <Zn>.<Tb>, #<const>
+	 */
+	var operation = [4]Operation{ARM64_SSHLLB, ARM64_SSHLLT, ARM64_USHLLB, ARM64_USHLLT}
+	tsz := ExtractBits(i.raw, 22, 1)<<2 | ExtractBits(i.raw, 19, 2)
+	esize := sveTszElementSize(tsz)
+	if ExtractBits(i.raw, 23, 1) != 0 || esize == 0 {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = operation[ExtractBits(i.raw, 10, 2)]
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize*2)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveImm(2, int64(tsz<<3|ExtractBits(i.raw, 16, 3))-int64(esize*8))
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_bitperm() (*Instruction, error) {
+	/* SVE2 Bit Permute
+	 *
+	 * {BEXT|BDEP|BGRP} <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	 */
+	var operation = [4]Operation{ARM64_BEXT, ARM64_BDEP, ARM64_BGRP, ARM64_UNDEFINED}
+	i.operation = operation[ExtractBits(i.raw, 10, 2)]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_abal() (*Instruction, error) {
+	/* SVE2 Integer Absolute Difference and Accumulate Long
+	 *
+	 * {SABALB|SABALT|UABALB|UABALT} <Zda>.<T>, <Zn>.<Tb>, <Zm>.<Tb>
+	 */
+	var operation = [4]Operation{ARM64_SABALB, ARM64_SABALT, ARM64_UABALB, ARM64_UABALT}
+	size := ExtractBits(i.raw, 22, 2)
+	if size == 0 {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(size)
+	i.operation = operation[ExtractBits(i.raw, 10, 2)]
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize/2)
+	i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize/2)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_add_carry() (*Instruction, error) {
+	/* SVE2 Integer Add/Subtract Long with Carry
+	 *
+	 * {ADCLB|ADCLT|SBCLB|SBCLT} <Zda>.<T>, <Zn>.<T>, <Zm>.<T>
+	 */
+	var operation = [4]Operation{ARM64_ADCLB, ARM64_ADCLT, ARM64_SBCLB, ARM64_SBCLT}
+	esize := uint32(4) << ExtractBits(i.raw, 22, 1)
+	i.operation = operation[ExtractBits(i.raw, 23, 1)<<1|ExtractBits(i.raw, 10, 1)]
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_complex_add() (*Instruction, error) {
+	/* SVE2 Complex Integer Add
+	 *
+	 * {CADD|SQCADD} <Zdn>.<T>, <Zdn>.<T>, <Zm>.<T>, #<const>
+	 */
+	var rotate = [2]int64{90, 270}
+	if ExtractBits(i.raw, 17, 4) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	rdn := ExtractBits(i.raw, 0, 5)
+	i.operation = [2]Operation{ARM64_CADD, ARM64_SQCADD}[ExtractBits(i.raw, 16, 1)]
+	i.setSveZReg(0, rdn, esize)
+	i.setSveZReg(1, rdn, esize)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveImm(3, rotate[ExtractBits(i.raw, 10, 1)])
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_shift_accumulate() (*Instruction, error) {
+	/* SVE2 Bitwise Shift and Accumulate/Insert
+	 *
+	 * {SSRA|USRA|SRSRA|URSRA|SRI|SLI} <Zd>.<T>, <Zn>.<T>, #<const>
+	 * {SABA|UABA} <Zda>.<T>, <Zn>.<T>, <Zm>.<T>
+	 */
+	var operation = [8]Operation{
+		ARM64_SSRA, ARM64_USRA, ARM64_SRSRA, ARM64_URSRA,
+		ARM64_SRI, ARM64_SLI, ARM64_SABA, ARM64_UABA,
+	}
+	op := ExtractBits(i.raw, 10, 3)
+	i.operation = operation[op]
+	if op >= 6 {
+		esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+		i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+		i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+		i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+		return i, nil
+	}
+	tsz := ExtractBits(i.raw, 22, 2)<<2 | ExtractBits(i.raw, 19, 2)
+	esize := sveTszElementSize(tsz)
+	if esize == 0 {
+		return nil, failedToDecodeInstruction
+	}
+	imm := tsz<<3 | ExtractBits(i.raw, 16, 3)
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	if i.operation == ARM64_SLI {
+		i.setSveImm(2, int64(imm-esize*8))
+	} else {
+		i.setSveImm(2, int64(2*esize*8-imm))
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_narrow_shift() (*Instruction, error) {
+	/* SVE2 Bitwise Shift Right Narrow
+	 *
+	 * <op> <Zd>.<T>, <Zn>.<Tb>, #<const>
+	 * {SQRSHRN|UQRSHRN|SQRSHRUN} <Zd>.H, { <Zn1>.S-<Zn2>.S }, #<const>
+	 */
+	var operation = [16]Operation{
+		ARM64_SQSHRUNB, ARM64_SQSHRUNT, ARM64_SQRSHRUNB, ARM64_SQRSHRUNT,
+		ARM64_SHRNB, ARM64_SHRNT, ARM64_RSHRNB, ARM64_RSHRNT,
+		ARM64_SQSHRNB, ARM64_SQSHRNT, ARM64_SQRSHRNB, ARM64_SQRSHRNT,
+		ARM64_UQSHRNB, ARM64_UQSHRNT, ARM64_UQRSHRNB, ARM64_UQRSHRNT,
+	}
+	op := ExtractBits(i.raw, 10, 4)
+	if ExtractBits(i.raw, 23, 1) == 1 {
+		var pair = [16]Operation{
+			ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_SQRSHRUN, ARM64_UNDEFINED,
+			ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+			ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_SQRSHRN, ARM64_UNDEFINED,
+			ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UQRSHRN, ARM64_UNDEFINED,
+		}
+		i.operation = pair[op]
+		if i.operation == ARM64_UNDEFINED || ExtractBits(i.raw, 20, 3) != 1 || ExtractBits(i.raw, 5, 1) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.setSveZReg(0, ExtractBits(i.raw, 0, 5), 2)
+		i.setSveZList(1, ExtractBits(i.raw, 5, 5), 2, 4)
+		i.setSveImm(2, int64(16-ExtractBits(i.raw, 16, 4)))
+		return i, nil
+	}
+	tsz := ExtractBits(i.raw, 22, 1)<<2 | ExtractBits(i.raw, 19, 2)
+	esize := sveTszElementSize(tsz)
+	if esize == 0 {
+		return nil, failedToDecodeInstruction
+	}
+	i.operation = operation[op]
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize*2)
+	i.setSveImm(2, int64(2*esize*8)-int64(tsz<<3|ExtractBits(i.raw, 16, 3)))
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_narrow_extract() (*Instruction, error) {
+	/* SVE2 Saturating Extract Narrow
+	 *
+	 * {SQXTNB|SQXTNT|UQXTNB|UQXTNT|SQXTUNB|SQXTUNT} <Zd>.<T>, <Zn>.<Tb>
+	 * {SQCVTN|UQCVTN|SQCVTUN} <Zd>.H, { <Zn1>.S-<Zn2>.S }
+	 */
+	var operation = [8]Operation{
+		ARM64_SQXTNB, ARM64_SQXTNT, ARM64_UQXTNB, ARM64_UQXTNT,
+		ARM64_SQXTUNB, ARM64_SQXTUNT, ARM64_UNDEFINED, ARM64_UNDEFINED,
+	}
+	var cvtn = [8]Operation{
+		ARM64_SQCVTN, ARM64_UNDEFINED, ARM64_UQCVTN, ARM64_UNDEFINED,
+		ARM64_SQCVTUN, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+	}
+	op := ExtractBits(i.raw, 10, 3)
+	if ExtractBits(i.raw, 23, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	if ExtractBits(i.raw, 16, 5) == 0x11 && ExtractBits(i.raw, 22, 1) == 0 {
+		i.operation = cvtn[op]
+		if i.operation == ARM64_UNDEFINED || ExtractBits(i.raw, 5, 1) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.setSveZReg(0, ExtractBits(i.raw, 0, 5), 2)
+		i.setSveZList(1, ExtractBits(i.raw, 5, 5), 2, 4)
+		return i, nil
+	}
+	tsz := ExtractBits(i.raw, 22, 1)<<2 | ExtractBits(i.raw, 19, 2)
+	i.operation = operation[op]
+	if i.operation == ARM64_UNDEFINED || ExtractBits(i.raw, 16, 3) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	var esize uint32
+	switch tsz {
+	case 1:
+		esize = 1
+	case 2:
+		esize = 2
+	case 4:
+		esize = 4
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize*2)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_add_sub_high() (*Instruction, error) {
+	/* SVE2 Integer Add/Subtract Narrow High Part
+	 *
+	 * {ADDHNB|ADDHNT|RADDHNB|RADDHNT|SUBHNB|SUBHNT|RSUBHNB|RSUBHNT} <Zd>.<T>, <Zn>.<Tb>, <Zm>.<Tb>
+	 */
+	var operation = [8]Operation{
+		ARM64_ADDHNB, ARM64_ADDHNT, ARM64_RADDHNB, ARM64_RADDHNT,
+		ARM64_SUBHNB, ARM64_SUBHNT, ARM64_RSUBHNB, ARM64_RSUBHNT,
+	}
+	size := ExtractBits(i.raw, 22, 2)
+	if size == 0 {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(size)
+	i.operation = operation[ExtractBits(i.raw, 10, 3)]
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize/2)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_match() (*Instruction, error) {
+	/* SVE2 Character Match
+	 *
+	 * {MATCH|NMATCH} <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>
+	 */
+	size := ExtractBits(i.raw, 22, 2)
+	if size > 1 {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(size)
+	i.operation = [2]Operation{ARM64_MATCH, ARM64_NMATCH}[ExtractBits(i.raw, 4, 1)]
+	i.setSvePReg(0, ExtractBits(i.raw, 0, 4), esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_ZERO)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZReg(3, ExtractBits(i.raw, 16, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_histogram() (*Instruction, error) {
+	/* SVE2 Histogram Computation
+	 *
+	 * HISTSEG <Zd>.B, <Zn>.B, <Zm>.B
+	 * HISTCNT <Zd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>
+	 */
+	size := ExtractBits(i.raw, 22, 2)
+	if ExtractBits(i.raw, 13, 3) == 5 {
+		if size != 0 || ExtractBits(i.raw, 10, 3) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = ARM64_HISTSEG
+		i.setSveZReg(0, ExtractBits(i.raw, 0, 5), 1)
+		i.setSveZReg(1, ExtractBits(i.raw, 5, 5), 1)
+		i.setSveZReg(2, ExtractBits(i.raw, 16, 5), 1)
+		return i, nil
+	}
+	if size < 2 {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(size)
+	i.operation = ARM64_HISTCNT
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_ZERO)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZReg(3, ExtractBits(i.raw, 16, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_crypto() (*Instruction, error) {
+	/* SVE2 Crypto Extensions
+	 *
+	 * {AESMC|AESIMC} <Zdn>.B, <Zdn>.B
+	 * {AESE|AESD} <Zdn>.B, <Zdn>.B, <Zm>.B
+	 * SM4E <Zdn>.S, <Zdn>.S, <Zm>.S
+	 * SM4EKEY <Zd>.S, <Zn>.S, <Zm>.S
+	 * RAX1 <Zd>.D, <Zn>.D, <Zm>.D
+	 */
+	if ExtractBits(i.raw, 22, 2) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	op := ExtractBits(i.raw, 10, 3)
+	opc := ExtractBits(i.raw, 16, 5)
+	rd := ExtractBits(i.raw, 0, 5)
+	rn := ExtractBits(i.raw, 5, 5)
+	switch {
+	case op == 0 && opc == 0 && rn == 0:
+		fallthrough
+	case op == 1 && opc == 0 && rn == 0:
+		i.operation = [2]Operation{ARM64_AESMC, ARM64_AESIMC}[op]
+		i.setSveZReg(0, rd, 1)
+		i.setSveZReg(1, rd, 1)
+	case op < 2 && opc == 2:
+		i.operation = [2]Operation{ARM64_AESE, ARM64_AESD}[op]
+		i.setSveZReg(0, rd, 1)
+		i.setSveZReg(1, rd, 1)
+		i.setSveZReg(2, rn, 1)
+	case op == 0 && opc == 3:
+		i.operation = ARM64_SM4E
+		i.setSveZReg(0, rd, 4)
+		i.setSveZReg(1, rd, 4)
+		i.setSveZReg(2, rn, 4)
+	case op == 4:
+		i.operation = ARM64_SM4EKEY
+		i.setSveZReg(0, rd, 4)
+		i.setSveZReg(1, rn, 4)
+		i.setSveZReg(2, opc, 4)
+	case op == 5:
+		i.operation = ARM64_RAX1
+		i.setSveZReg(0, rd, 8)
+		i.setSveZReg(1, rn, 8)
+		i.setSveZReg(2, opc, 8)
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_fp_pairwise() (*Instruction, error) {
+	/* SVE2 Floating-point Pairwise Operations
+	 *
+	 * {FADDP|FMAXNMP|FMINNMP|FMAXP|FMINP} <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, <Zm>.<T>
+	 */
+	var operation = [8]Operation{
+		ARM64_FADDP, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+		ARM64_FMAXNMP, ARM64_FMINNMP, ARM64_FMAXP, ARM64_FMINP,
+	}
+	i.operation = operation[ExtractBits(i.raw, 16, 3)]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	rdn := ExtractBits(i.raw, 0, 5)
+	i.setSveZReg(0, rdn, esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+	i.setSveZReg(2, rdn, esize)
+	i.setSveZReg(3, ExtractBits(i.raw, 5, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_fp_convert_odd() (*Instruction, error) {
+	/* SVE2 Floating-point Convert Precision Odd Elements
+	 *
+	 * {FCVTNT|FCVTXNT|BFCVTNT} <Zd>.<T>, <Pg>/M, <Zn>.<Tb>
+	 * FCVTLT <Zd>.<T>, <Pg>/M, <Zn>.<Tb>
+	 */
+	var operation = [16]Operation{
+		2:  ARM64_FCVTXNT,
+		8:  ARM64_FCVTNT,
+		9:  ARM64_FCVTLT,
+		10: ARM64_BFCVTNT,
+		14: ARM64_FCVTNT,
+		15: ARM64_FCVTLT,
+	}
+	// destination and source element sizes indexed by opc:opc2
+	var sizes = [16][2]uint32{
+		2: {4, 8}, 8: {2, 4}, 9: {4, 2}, 10: {2, 4}, 14: {4, 8}, 15: {8, 4},
+	}
+	op := ExtractBits(i.raw, 22, 2)<<2 | ExtractBits(i.raw, 16, 2)
+	i.operation = operation[op]
+	if i.operation == ARM64_UNDEFINED {
+		return nil, failedToDecodeInstruction
+	}
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), sizes[op][0])
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_MERGE)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), sizes[op][1])
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_fp_reduce_quadword() (*Instruction, error) {
+	/* SVE2 Floating-point Recursive Reduction (Quadwords)
+	 *
+	 * {FADDQV|FMAXNMQV|FMINNMQV|FMAXQV|FMINQV} <Vd>.<T>, <Pg>, <Zn>.<Tb>
+	 */
+	var operation = [8]Operation{
+		ARM64_FADDQV, ARM64_UNDEFINED, ARM64_UNDEFINED, ARM64_UNDEFINED,
+		ARM64_FMAXNMQV, ARM64_FMINNMQV, ARM64_FMAXQV, ARM64_FMINQV,
+	}
+	i.operation = operation[ExtractBits(i.raw, 16, 3)]
+	if i.operation == ARM64_UNDEFINED || ExtractBits(i.raw, 22, 2) == 0 {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(ExtractBits(i.raw, 22, 2))
+	i.setSveVReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSvePred(1, ExtractBits(i.raw, 10, 3), PRED_NONE)
+	i.setSveZReg(2, ExtractBits(i.raw, 5, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_fp_clamp() (*Instruction, error) {
+	/* SVE2 Floating-point Clamp
+	 *
+	 * FCLAMP <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	 */
+	size := ExtractBits(i.raw, 22, 2)
+	if size == 0 {
+		return nil, failedToDecodeInstruction
+	}
+	esize := sveElementSize(size)
+	i.operation = ARM64_FCLAMP
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), esize)
+	i.setSveZReg(2, ExtractBits(i.raw, 16, 5), esize)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_fp_widening_indexed() (*Instruction, error) {
+	/* SVE2 Floating-point Widening Multiply-Add (Indexed)
+	 *
+	 * {FMLALB|FMLALT|FMLSLB|FMLSLT} <Zda>.S, <Zn>.H, <Zm>.H[<imm>]
+	 * {BFMLALB|BFMLALT|BFMLSLB|BFMLSLT} <Zda>.S, <Zn>.H, <Zm>.H[<imm>]
+	 * {FDOT|BFDOT} <Zda>.S, <Zn>.H, <Zm>.H[<imm>]
+	 */
+	var operation = [2][2][2]Operation{
+		{{ARM64_FMLALB, ARM64_FMLALT}, {ARM64_FMLSLB, ARM64_FMLSLT}},
+		{{ARM64_BFMLALB, ARM64_BFMLALT}, {ARM64_BFMLSLB, ARM64_BFMLSLT}},
+	}
+	op := ExtractBits(i.raw, 13, 1)
+	zm := ExtractBits(i.raw, 16, 3)
+	switch size := ExtractBits(i.raw, 22, 2); size {
+	case 0:
+		fallthrough
+	case 1:
+		if op != 0 || ExtractBits(i.raw, 10, 2) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = [2]Operation{ARM64_FDOT, ARM64_BFDOT}[size]
+		i.setSveZReg(0, ExtractBits(i.raw, 0, 5), 4)
+		i.setSveZReg(1, ExtractBits(i.raw, 5, 5), 2)
+		i.setSveZRegIndexed(2, zm, 2, ExtractBits(i.raw, 19, 2))
+	default:
+		i.operation = operation[size&1][op][ExtractBits(i.raw, 10, 1)]
+		i.setSveZReg(0, ExtractBits(i.raw, 0, 5), 4)
+		i.setSveZReg(1, ExtractBits(i.raw, 5, 5), 2)
+		i.setSveZRegIndexed(2, zm, 2, ExtractBits(i.raw, 19, 2)<<1|ExtractBits(i.raw, 11, 1))
+	}
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_fp_widening() (*Instruction, error) {
+	/* SVE2 Floating-point Widening Multiply-Add
+	 *
+	 * {FMLALB|FMLALT|FMLSLB|FMLSLT} <Zda>.S, <Zn>.H, <Zm>.H
+	 * {BFMLALB|BFMLALT|BFMLSLB|BFMLSLT} <Zda>.S, <Zn>.H, <Zm>.H
+	 * {FDOT|BFDOT} <Zda>.S, <Zn>.H, <Zm>.H
+	 */
+	var operation = [2][2][2]Operation{
+		{{ARM64_FMLALB, ARM64_FMLALT}, {ARM64_FMLSLB, ARM64_FMLSLT}},
+		{{ARM64_BFMLALB, ARM64_BFMLALT}, {ARM64_BFMLSLB, ARM64_BFMLSLT}},
+	}
+	op := ExtractBits(i.raw, 13, 1)
+	if ExtractBits(i.raw, 11, 1) != 0 {
+		return nil, failedToDecodeInstruction
+	}
+	switch size := ExtractBits(i.raw, 22, 2); size {
+	case 0:
+		fallthrough
+	case 1:
+		if op != 0 || ExtractBits(i.raw, 10, 1) != 0 {
+			return nil, failedToDecodeInstruction
+		}
+		i.operation = [2]Operation{ARM64_FDOT, ARM64_BFDOT}[size]
+	default:
+		i.operation = operation[size&1][op][ExtractBits(i.raw, 10, 1)]
+	}
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), 4)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), 2)
+	i.setSveZReg(2, ExtractBits(i.raw, 16, 5), 2)
+	return i, nil
+}
+
+func (i *Instruction) decompose_sve2_fp_mmla() (*Instruction, error) {
+	/* SVE Floating-point Matrix Multiply-Accumulate
+	 *
+	 * FMMLA <Zda>.<T>, <Zn>.<T>, <Zm>.<T>
+	 * BFMMLA <Zda>.S, <Zn>.H, <Zm>.H
+	 */
+	if ExtractBits(i.raw, 10, 2) != 1 {
+		return nil, failedToDecodeInstruction
+	}
+	var esize, srcSize uint32
+	switch ExtractBits(i.raw, 22, 2) {
+	case 1:
+		i.operation = ARM64_BFMMLA
+		esize, srcSize = 4, 2
+	case 2:
+		i.operation = ARM64_FMMLA
+		esize, srcSize = 4, 4
+	case 3:
+		i.operation = ARM64_FMMLA
+		esize, srcSize = 8, 8
+	default:
+		return nil, failedToDecodeInstruction
+	}
+	i.setSveZReg(0, ExtractBits(i.raw, 0, 5), esize)
+	i.setSveZReg(1, ExtractBits(i.raw, 5, 5), srcSize)
+	i.setSveZReg(2, ExtractBits(i.raw, 16, 5), srcSize)
+	return i, nil
+}
@@ -42,7 +42,7 @@ func (i *Instruction) disassemble(decimalImm bool) (string, error) {
 			i.operands[idx].strRepr = operand.strRepr
 			break
 		case IMPLEMENTATION_SPECIFIC:
-			if err := operand.getImplementationSpecific(); err != nil {
+			if err := operand.getImplementationSpecific(i.vendor); err != nil {
 				return "", fmt.Errorf("failed to disassemble operation: %v", err)
 			}
 			i.operands[idx].strRepr = operand.strRepr
@@ -64,6 +64,21 @@ func (i *Instruction) disassemble(decimalImm bool) (string, error) {
 		case CONDITION:
 			i.operands[idx].strRepr = Condition(operand.Reg[0]).String()
 			break
+		case SVE_PATTERN:
+			if err := operand.getSvePattern(); err != nil {
+				return "", fmt.Errorf("failed to disassemble operation: %v", err)
+			}
+			i.operands[idx].strRepr = operand.strRepr
+			break
+		case SME_TILE:
+			if err := operand.getSmeTile(0); err != nil {
+				return "", fmt.Errorf("failed to disassemble operation: %v", err)
+			}
+			i.operands[idx].strRepr = operand.strRepr
+			break
+		case SME_TILE_LIST:
+			i.operands[idx].strRepr = operand.getSmeTileList()
+			break
 		case NONE:
 			break
 		}
@@ -78,13 +93,14 @@ func (i *Instruction) disassemble(decimalImm bool) (string, error) {
 		}
 	}
 
-	return fmt.Sprintf("%s%s%s%s%s%s",
+	return fmt.Sprintf("%s%s%s%s%s%s%s",
 		i.operation,
 		i.operands[0],
 		i.operands[1],
 		i.operands[2],
 		i.operands[3],
-		i.operands[4]), nil
+		i.operands[4],
+		i.operands[5]), nil
 }
 
 func (op *InstructionOperand) getShiftedImmediate(decimalImm bool) error {
@@ -195,6 +211,9 @@ func (op *InstructionOperand) getRegister(registerNumber int, decimalImm bool) e
 	if op.HasScale || op.Scale > 0 {
 		scale = fmt.Sprintf("[%d]", 0x7fffffff&op.Scale)
 	}
+	if op.IndexReg != uint32(REG_NONE) {
+		scale = fmt.Sprintf("[%s, %d]", Register(op.IndexReg), op.Immediate)
+	}
 
 	if op.OpClass == SYS_REG {
 		op.strRepr = fmt.Sprintf("%s", SystemReg(op.Reg[registerNumber]))
@@ -203,10 +222,20 @@ func (op *InstructionOperand) getRegister(registerNumber int, decimalImm bool) e
 		return operandIsNotRegister
 	}
 
+	if r := Register(op.Reg[registerNumber]); r >= REG_ZA && r <= REG_ZA15 {
+		return op.getSmeTile(registerNumber)
+	}
+
 	if op.ShiftType != SHIFT_NONE {
 		return op.getShiftedRegister(registerNumber, decimalImm)
 	} else if op.ElementSize == 0 {
-		op.strRepr = fmt.Sprintf("%s", Register(op.Reg[registerNumber]))
+		if !op.HasScale {
+			scale = ""
+		}
+		op.strRepr = fmt.Sprintf("%s%s%s", Register(op.Reg[registerNumber]), scale, op.PredQual)
+		if op.Writeback {
+			op.strRepr += "!"
+		}
 		if !decimalImm {
 			if strings.HasPrefix(op.strRepr, "#") {
 				i, err := strconv.Atoi(strings.TrimPrefix(op.strRepr, "#"))
@@ -223,25 +252,9 @@ func (op *InstructionOperand) getRegister(registerNumber int, decimalImm bool) e
 		rotate = fmt.Sprintf(", #%d", op.Rotation)
 	}
 
-	var elementSize string
-	switch op.ElementSize {
-	case 1:
-		elementSize = "b"
-		break
-	case 2:
-		elementSize = "h"
-		break
-	case 4:
-		elementSize = "s"
-		break
-	case 8:
-		elementSize = "d"
-		break
-	case 16:
-		elementSize = "q"
-		break
-	default:
-		return failedToDisassembleRegister
+	elementSize, err := getElementSize(op.ElementSize)
+	if err != nil {
+		return err
 	}
 
 	if op.DataSize != 0 {
@@ -259,6 +272,22 @@ func (op *InstructionOperand) getRegister(registerNumber int, decimalImm bool) e
 	return nil
 }
 
+func getElementSize(size uint32) (string, error) {
+	switch size {
+	case 1:
+		return "b", nil
+	case 2:
+		return "h", nil
+	case 4:
+		return "s", nil
+	case 8:
+		return "d", nil
+	case 16:
+		return "q", nil
+	}
+	return "", failedToDisassembleRegister
+}
+
 func (op *InstructionOperand) getShiftedRegister(registerNumber int, decimalImm bool) error {
 	var immBuff string
 	var shiftBuff string
@@ -284,7 +313,7 @@ func (op *InstructionOperand) getShiftedRegister(registerNumber int, decimalImm
 
 func (op *InstructionOperand) getMultiregOperand(decimalImm bool) error {
 	var indexBuff string
-	var registers []Register
+	var registers []string
 	var elementCount int
 
 	for _, opReg := range op.Reg {
@@ -292,7 +321,7 @@ func (op *InstructionOperand) getMultiregOperand(decimalImm bool) error {
 			if err := op.getRegister(elementCount, decimalImm); err != nil {
 				return err
 			}
-			registers = append(registers, Register(opReg))
+			registers = append(registers, op.strRepr)
 			elementCount++
 		}
 	}
@@ -321,7 +350,11 @@ func (op *InstructionOperand) getMultiregOperand(decimalImm bool) error {
 	return nil
 }
 
-func (op *InstructionOperand) getImplementationSpecific() error {
+func (op *InstructionOperand) getImplementationSpecific(vendor Vendor) error {
+	if name, ok := LookupSystemRegister(vendor, op.Reg[0], op.Reg[1], op.Reg[2], op.Reg[3], op.Reg[4]); ok {
+		op.strRepr = name
+		return nil
+	}
 	op.strRepr = fmt.Sprintf("s%d_%d_c%d_c%d_%d", op.Reg[0], op.Reg[1], op.Reg[2], op.Reg[3], op.Reg[4])
 	return nil
 }
@@ -333,13 +366,14 @@ func (op *InstructionOperand) getMemoryOperand(decimalImm bool) error {
 	var outBuffer string
 	var sign string
 
-	reg1 := Register(op.Reg[0])
-	reg2 := Register(op.Reg[1])
-
 	if op == nil {
 		return failedToDisassembleOperand
 	}
 
+	reg1 := Register(op.Reg[0])
+	reg2 := Register(op.Reg[1])
+	base := op.getMemoryRegister(0)
+
 	imm := int64(op.Immediate)
 
 	if op.SignedImm == 1 && imm < 0 {
@@ -349,13 +383,16 @@ func (op *InstructionOperand) getMemoryOperand(decimalImm bool) error {
 
 	switch op.OpClass {
 	case MEM_REG:
-		outBuffer = fmt.Sprintf("[%s]", Register(op.Reg[0]))
+		outBuffer = fmt.Sprintf("[%s]", base)
+		if op.Writeback {
+			outBuffer += "!"
+		}
 		break
 	case MEM_PRE_IDX:
 		if decimalImm {
-			outBuffer = fmt.Sprintf("[%s, #%s%d]!", Register(op.Reg[0]), sign, uint64(imm))
+			outBuffer = fmt.Sprintf("[%s, #%s%d]!", base, sign, uint64(imm))
 		} else {
-			outBuffer = fmt.Sprintf("[%s, #%s%#x]!", Register(op.Reg[0]), sign, uint64(imm))
+			outBuffer = fmt.Sprintf("[%s, #%s%#x]!", base, sign, uint64(imm))
 		}
 		break
 	case MEM_POST_IDX: // [<reg>], <reg|imm>
@@ -368,7 +405,7 @@ func (op *InstructionOperand) getMemoryOperand(decimalImm bool) error {
 				paramBuff = fmt.Sprintf(", #%s%#x", sign, uint64(imm))
 			}
 		}
-		outBuffer = fmt.Sprintf("[%s]%s", Register(op.Reg[0]), paramBuff)
+		outBuffer = fmt.Sprintf("[%s]%s", base, paramBuff)
 		break
 	case MEM_OFFSET: // [<reg> optional(imm)]
 		if imm != 0 {
@@ -377,8 +414,11 @@ func (op *InstructionOperand) getMemoryOperand(decimalImm bool) error {
 			} else {
 				immBuff = fmt.Sprintf(", #%s%#x", sign, uint64(imm))
 			}
+			if op.MulVl {
+				immBuff += ", mul vl"
+			}
 		}
-		outBuffer = fmt.Sprintf("[%s%s]", Register(op.Reg[0]), immBuff)
+		outBuffer = fmt.Sprintf("[%s%s]", base, immBuff)
 		break
 	case MEM_EXTENDED:
 		if reg1 == REG_NONE || reg2 == REG_NONE {
@@ -394,7 +434,7 @@ func (op *InstructionOperand) getMemoryOperand(decimalImm bool) error {
 		if op.ShiftType != SHIFT_NONE {
 			extendBuff = fmt.Sprintf(", %s%s", ShiftType(op.ShiftType), immBuff)
 		}
-		outBuffer = fmt.Sprintf("[%s, %s%s]", reg1, reg2, extendBuff)
+		outBuffer = fmt.Sprintf("[%s, %s%s]", base, op.getMemoryRegister(1), extendBuff)
 		break
 	default:
 		return notMemoryOperand
@@ -404,3 +444,76 @@ func (op *InstructionOperand) getMemoryOperand(decimalImm bool) error {
 
 	return nil
 }
+
+// getMemoryRegister returns the name of a memory operand register, adding the
+// element size suffix for SVE vector bases and offsets
+func (op *InstructionOperand) getMemoryRegister(registerNumber int) string {
+	r := Register(op.Reg[registerNumber])
+	if r >= REG_Z0 && r <= REG_Z31 {
+		if elementSize, err := getElementSize(op.ElementSize); err == nil {
+			return fmt.Sprintf("%s.%s", r, elementSize)
+		}
+	}
+	return r.String()
+}
+
+func (op *InstructionOperand) getSvePattern() error {
+	pattern := SvePattern(op.Reg[0])
+	if op.Immediate > 1 {
+		op.strRepr = fmt.Sprintf("%s, mul #%d", pattern, op.Immediate)
+	} else {
+		op.strRepr = pattern.String()
+	}
+	return nil
+}
+
+// getSmeTile formats a ZA array, tile or tile slice operand such as za,
+// za[<Wv>, <imm>], za<n>.<T> or za<n><h|v>.<T>[<Wv>, <imm>]
+func (op *InstructionOperand) getSmeTile(registerNumber int) error {
+	var elementSize string
+	var index string
+
+	r := Register(op.Reg[registerNumber])
+	if r < REG_ZA || r > REG_ZA15 {
+		return failedToDisassembleRegister
+	}
+	if op.ElementSize != 0 {
+		size, err := getElementSize(op.ElementSize)
+		if err != nil {
+			return err
+		}
+		elementSize = "." + size
+	}
+	if op.IndexReg != uint32(REG_NONE) {
+		index = fmt.Sprintf("[%s, %d]", Register(op.IndexReg), op.Immediate)
+	}
+	op.strRepr = fmt.Sprintf("%s%s%s%s", r, op.Slice, elementSize, index)
+	return nil
+}
+
+// getSmeTileList formats the ZERO tile mask held in Immediate, using the same
+// za/.h/.s aliases as LLVM and falling back to a list of 64-bit tiles
+func (op *InstructionOperand) getSmeTileList() string {
+	var tiles []string
+
+	mask := op.Immediate & 0xff
+	switch {
+	case mask == 0xff:
+		return "{za}"
+	case mask == 0x55 || mask == 0xaa:
+		return fmt.Sprintf("{za%d.h}", mask>>7)
+	case mask != 0 && mask&0xf == mask>>4:
+		for n := 0; n < 4; n++ {
+			if mask&(1<<n) != 0 {
+				tiles = append(tiles, fmt.Sprintf("za%d.s", n))
+			}
+		}
+	default:
+		for n := 0; n < 8; n++ {
+			if mask&(1<<n) != 0 {
+				tiles = append(tiles, fmt.Sprintf("za%d.d", n))
+			}
+		}
+	}
+	return fmt.Sprintf("{%s}", strings.Join(tiles, ", "))
+}
@@ -0,0 +1,705 @@
+package arm64
+
+import (
+	"io"
+	"math/bits"
+	"strings"
+)
+
+//---------------------------------------------
+// Architecture features
+//---------------------------------------------
+
+// Features is a set of the FEAT_* architecture extensions an instruction
+// needs, or that a target profile implements
+type Features uint64
+
+const (
+	FEAT_AES Features = 1 << iota
+	FEAT_SHA1
+	FEAT_SHA256
+	FEAT_CRC32
+	FEAT_LSE
+	FEAT_RDM
+	FEAT_LOR
+	FEAT_RAS
+	FEAT_SPE
+	FEAT_DotProd
+	FEAT_FHM
+	FEAT_SHA3
+	FEAT_SM4
+	FEAT_PAuth
+	FEAT_JSCVT
+	FEAT_FCMA
+	FEAT_LRCPC
+	FEAT_LRCPC2
+	FEAT_FlagM
+	FEAT_FlagM2
+	FEAT_FRINTTS
+	FEAT_SB
+	FEAT_SPECRES
+	FEAT_BTI
+	FEAT_MTE
+	FEAT_BF16
+	FEAT_I8MM
+	FEAT_LS64
+	FEAT_LS64_V
+	FEAT_LS64_ACCDATA
+	FEAT_WFxT
+	FEAT_MOPS
+	FEAT_CSSC
+	FEAT_LRCPC3
+	FEAT_LSE128
+	FEAT_THE
+	FEAT_RPRFM
+	FEAT_SYSREG128
+	FEAT_SYSINSTR128
+	FEAT_GCS
+	FEAT_TME
+	FEAT_BRBE
+	FEAT_SVE
+	FEAT_SVE2
+	FEAT_SVE2p1
+	FEAT_SVE_BitPerm
+	FEAT_SVE_SHA3
+	FEAT_SVE_SM4
+	FEAT_SME
+	FEAT_FP16
+	FEAT_SHA512
+	FEAT_SM3
+	FEAT_SVE_AES
+)
+
+var featureNames = []string{
+	"FEAT_AES",
+	"FEAT_SHA1",
+	"FEAT_SHA256",
+	"FEAT_CRC32",
+	"FEAT_LSE",
+	"FEAT_RDM",
+	"FEAT_LOR",
+	"FEAT_RAS",
+	"FEAT_SPE",
+	"FEAT_DotProd",
+	"FEAT_FHM",
+	"FEAT_SHA3",
+	"FEAT_SM4",
+	"FEAT_PAuth",
+	"FEAT_JSCVT",
+	"FEAT_FCMA",
+	"FEAT_LRCPC",
+	"FEAT_LRCPC2",
+	"FEAT_FlagM",
+	"FEAT_FlagM2",
+	"FEAT_FRINTTS",
+	"FEAT_SB",
+	"FEAT_SPECRES",
+	"FEAT_BTI",
+	"FEAT_MTE",
+	"FEAT_BF16",
+	"FEAT_I8MM",
+	"FEAT_LS64",
+	"FEAT_LS64_V",
+	"FEAT_LS64_ACCDATA",
+	"FEAT_WFxT",
+	"FEAT_MOPS",
+	"FEAT_CSSC",
+	"FEAT_LRCPC3",
+	"FEAT_LSE128",
+	"FEAT_THE",
+	"FEAT_RPRFM",
+	"FEAT_SYSREG128",
+	"FEAT_SYSINSTR128",
+	"FEAT_GCS",
+	"FEAT_TME",
+	"FEAT_BRBE",
+	"FEAT_SVE",
+	"FEAT_SVE2",
+	"FEAT_SVE2p1",
+	"FEAT_SVE_BitPerm",
+	"FEAT_SVE_SHA3",
+	"FEAT_SVE_SM4",
+	"FEAT_SME",
+	"FEAT_FP16",
+	"FEAT_SHA512",
+	"FEAT_SM3",
+	"FEAT_SVE_AES",
+}
+
+// Has reports whether f contains all of the features in g
+func (f Features) Has(g Features) bool {
+	return f&g == g
+}
+
+// List returns the individual features of f in bit order
+func (f Features) List() []Features {
+	var list []Features
+	for f != 0 {
+		bit := Features(1) << uint(bits.TrailingZeros64(uint64(f)))
+		list = append(list, bit)
+		f &^= bit
+	}
+	return list
+}
+
+func (f Features) String() string {
+	if f == 0 {
+		return "none"
+	}
+	var names []string
+	for _, bit := range f.List() {
+		names = append(names, featureNames[bits.TrailingZeros64(uint64(bit))])
+	}
+	return strings.Join(names, "|")
+}
+
+// ArchVersion is an A-profile architecture version
+type ArchVersion uint32
+
+const (
+	ARCH_ANY ArchVersion = iota // no restriction
+	ARCH_V8_0
+	ARCH_V8_1
+	ARCH_V8_2
+	ARCH_V8_3
+	ARCH_V8_4
+	ARCH_V8_5
+	ARCH_V8_6
+	ARCH_V8_7
+	ARCH_V8_8
+	ARCH_V8_9
+	ARCH_V9_0
+	ARCH_V9_1
+	ARCH_V9_2
+	ARCH_V9_3
+	ARCH_V9_4
+)
+
+func (v ArchVersion) String() string {
+	return []string{
+		"any",
+		"v8.0", "v8.1", "v8.2", "v8.3", "v8.4", "v8.5", "v8.6", "v8.7", "v8.8", "v8.9",
+		"v9.0", "v9.1", "v9.2", "v9.3", "v9.4",
+	}[v]
+}
+
+// Features returns the features that are mandatory in architecture version v
+// and have instructions. The optional ones, e.g. FEAT_AES, FEAT_MTE or
+// FEAT_SME, have to be added to Options.Features
+func (v ArchVersion) Features() Features {
+	var f Features
+	switch v {
+	case ARCH_ANY:
+		return ^Features(0)
+	case ARCH_V9_4:
+		f |= ARCH_V8_9.Features()
+		fallthrough
+	case ARCH_V9_3:
+		f |= ARCH_V8_8.Features()
+		fallthrough
+	case ARCH_V9_2:
+		f |= ARCH_V8_7.Features()
+		fallthrough
+	case ARCH_V9_1:
+		f |= ARCH_V8_6.Features()
+		fallthrough
+	case ARCH_V9_0:
+		return f | ARCH_V8_5.Features() | FEAT_SVE | FEAT_SVE2
+	case ARCH_V8_9:
+		f |= FEAT_CSSC
+		fallthrough
+	case ARCH_V8_8:
+		f |= FEAT_MOPS
+		fallthrough
+	case ARCH_V8_7:
+		f |= FEAT_WFxT
+		fallthrough
+	case ARCH_V8_6:
+		f |= FEAT_BF16 | FEAT_I8MM
+		fallthrough
+	case ARCH_V8_5:
+		f |= FEAT_FlagM2 | FEAT_FRINTTS | FEAT_SB | FEAT_SPECRES | FEAT_BTI
+		fallthrough
+	case ARCH_V8_4:
+		f |= FEAT_LRCPC2 | FEAT_FlagM | FEAT_DotProd
+		fallthrough
+	case ARCH_V8_3:
+		f |= FEAT_PAuth | FEAT_JSCVT | FEAT_FCMA | FEAT_LRCPC
+		fallthrough
+	case ARCH_V8_2:
+		f |= FEAT_RAS
+		fallthrough
+	case ARCH_V8_1:
+		f |= FEAT_CRC32 | FEAT_LSE | FEAT_RDM | FEAT_LOR
+	}
+	return f
+}
+
+// gated reports whether o restricts decoding to a target profile
+func (o Options) gated() bool {
+	return o.Arch != ARCH_ANY || o.Features != 0
+}
+
+// profile returns the features of the target profile of o, v8.0 when only
+// Options.Features is set
+func (o Options) profile() Features {
+	if o.Arch == ARCH_ANY {
+		return ARCH_V8_0.Features() | o.Features
+	}
+	return o.Arch.Features() | o.Features
+}
+
+// isHint reports whether word is in the hint space, e.g. PACIASP or BTI, which
+// executes as a NOP on cores without the feature
+func isHint(word uint32) bool {
+	return word&0xfffff01f == 0xd503201f
+}
+
+// RequiredFeatures returns the features needed by the general purpose and
+// Advanced SIMD encodings of o, none for the base architecture. The SVE and SME
+// encodings of an operation, e.g. SVE SDOT, need FEAT_SVE or FEAT_SME instead
+func (o Operation) RequiredFeatures() Features {
+	return operationFeatures[o]
+}
+
+// Features returns the features needed by the encoding of i, none for the base
+// architecture. Unlike Operation.RequiredFeatures it tells the SVE, SME and
+// general purpose encodings of an operation apart
+func (i *Instruction) Features() Features {
+	return i.requiredFeatures()
+}
+
+// requiredFeatures returns the features needed by the encoding of i
+func (i *Instruction) requiredFeatures() Features {
+	switch i.group {
+	case GROUP_SME:
+		return FEAT_SME
+	case GROUP_SVE:
+		if f := i.sveFormFeatures(); f != 0 {
+			return f
+		}
+		if f, ok := sveFeatures[i.operation]; ok {
+			return f
+		}
+		return FEAT_SVE
+	case GROUP_DATA_PROCESSING_IMM, GROUP_DATA_PROCESSING_REG:
+		switch i.operation {
+		case ARM64_ABS, ARM64_CNT, ARM64_SMAX, ARM64_SMIN, ARM64_UMAX, ARM64_UMIN:
+			// The general purpose register forms of the Advanced SIMD operations
+			return FEAT_CSSC
+		}
+	}
+	f := operationFeatures[i.operation]
+	if i.isHalfPrecision() {
+		// e.g. FCMLA v0.8h needs FEAT_FCMA and FEAT_FP16
+		f |= FEAT_FP16
+	}
+	return f
+}
+
+// sveFormFeatures returns the features of the SVE2 forms of operations that
+// are also in SVE, e.g. the unpredicated MUL z0.b, z1.b, z2.b, or none when i
+// is the SVE form
+func (i *Instruction) sveFormFeatures() Features {
+	switch i.operation {
+	case ARM64_MUL, ARM64_SMULH, ARM64_UMULH, ARM64_MLA, ARM64_MLS:
+		// The SVE forms are predicated or take an immediate
+		if i.operands[1].OpClass == REG && !isPredicate(i.operands[1].Reg[0]) && i.operands[2].OpClass == REG {
+			return FEAT_SVE2
+		}
+	case ARM64_SQADD, ARM64_UQADD, ARM64_SQSUB, ARM64_UQSUB:
+		// The SVE forms are unpredicated
+		if isPredicate(i.operands[1].Reg[0]) {
+			return FEAT_SVE2
+		}
+	case ARM64_TBL, ARM64_SPLICE, ARM64_EXT:
+		// The SVE2 forms take a list of two registers
+		for _, op := range i.operands {
+			if op.OpClass == MULTI_REG && op.Reg[1] != 0 {
+				return FEAT_SVE2
+			}
+		}
+	case ARM64_LDNT1B, ARM64_LDNT1H, ARM64_LDNT1W, ARM64_LDNT1D,
+		ARM64_STNT1B, ARM64_STNT1H, ARM64_STNT1W, ARM64_STNT1D:
+		// The SVE2 forms gather or scatter with a vector base
+		if base := Register(i.operands[2].Reg[0]); base >= REG_Z0 && base <= REG_Z31 {
+			return FEAT_SVE2
+		}
+	case ARM64_PMULLB, ARM64_PMULLT:
+		if i.operands[0].ElementSize == 16 {
+			return FEAT_SVE_AES
+		}
+	}
+	return 0
+}
+
+// isPredicate reports whether reg is an SVE predicate register
+func isPredicate(reg uint32) bool {
+	return (reg >= uint32(REG_P0) && reg <= uint32(REG_P15)) || (reg >= uint32(REG_PN0) && reg <= uint32(REG_PN15))
+}
+
+// isHalfPrecision reports whether i is in one of the half-precision encodings
+// of FEAT_FP16, e.g. FADD h0, h1, h2
+func (i *Instruction) isHalfPrecision() bool {
+	for _, enc := range fp16Encodings {
+		if i.raw&enc.mask == enc.value {
+			return true
+		}
+	}
+	return false
+}
+
+func featureTable(table []struct {
+	features   Features
+	operations []Operation
+}) map[Operation]Features {
+	m := make(map[Operation]Features)
+	for _, t := range table {
+		for _, op := range t.operations {
+			m[op] = t.features
+		}
+	}
+	return m
+}
+
+// operationFeatures maps the operations of the extensions to the features
+// that introduce them, seeded from the v8.x, v9.x, MTE and MOPS test suites
+var operationFeatures = featureTable([]struct {
+	features   Features
+	operations []Operation
+}{
+	{FEAT_AES, []Operation{
+		ARM64_AESD, ARM64_AESE, ARM64_AESIMC, ARM64_AESMC,
+	}},
+	{FEAT_SHA1, []Operation{
+		ARM64_SHA1C, ARM64_SHA1H, ARM64_SHA1M, ARM64_SHA1P, ARM64_SHA1SU0, ARM64_SHA1SU1,
+	}},
+	{FEAT_SHA256, []Operation{
+		ARM64_SHA256H, ARM64_SHA256H2, ARM64_SHA256SU0, ARM64_SHA256SU1,
+	}},
+	{FEAT_CRC32, []Operation{
+		ARM64_CRC32B, ARM64_CRC32CB, ARM64_CRC32CH, ARM64_CRC32CW, ARM64_CRC32CX, ARM64_CRC32H,
+		ARM64_CRC32W, ARM64_CRC32X,
+	}},
+	{FEAT_LSE, []Operation{
+		ARM64_CASB, ARM64_CASAB, ARM64_CASALB, ARM64_CASLB, ARM64_CASH, ARM64_CASAH, ARM64_CASALH,
+		ARM64_CASLH, ARM64_CASP, ARM64_CASPA, ARM64_CASPAL, ARM64_CASPL, ARM64_CAS, ARM64_CASA,
+		ARM64_CASAL, ARM64_CASL, ARM64_LDADDB, ARM64_LDADDAB, ARM64_LDADDALB, ARM64_LDADDLB,
+		ARM64_LDADDH, ARM64_LDADDAH, ARM64_LDADDALH, ARM64_LDADDLH, ARM64_LDADD, ARM64_LDADDA,
+		ARM64_LDADDAL, ARM64_LDADDL, ARM64_LDCLRB, ARM64_LDCLRAB, ARM64_LDCLRALB, ARM64_LDCLRLB,
+		ARM64_LDCLRH, ARM64_LDCLRAH, ARM64_LDCLRALH, ARM64_LDCLRLH, ARM64_LDCLR, ARM64_LDCLRA,
+		ARM64_LDCLRAL, ARM64_LDCLRL, ARM64_LDEORB, ARM64_LDEORAB, ARM64_LDEORALB, ARM64_LDEORLB,
+		ARM64_LDEORH, ARM64_LDEORAH, ARM64_LDEORALH, ARM64_LDEORLH, ARM64_LDEOR, ARM64_LDEORA,
+		ARM64_LDEORAL, ARM64_LDEORL, ARM64_LDSETB, ARM64_LDSETAB, ARM64_LDSETALB, ARM64_LDSETLB,
+		ARM64_LDSETH, ARM64_LDSETAH, ARM64_LDSETALH, ARM64_LDSETLH, ARM64_LDSET, ARM64_LDSETA,
+		ARM64_LDSETAL, ARM64_LDSETL, ARM64_LDSMAXB, ARM64_LDSMAXAB, ARM64_LDSMAXALB, ARM64_LDSMAXLB,
+		ARM64_LDSMAXH, ARM64_LDSMAXAH, ARM64_LDSMAXALH, ARM64_LDSMAXLH, ARM64_LDSMAX, ARM64_LDSMAXA,
+		ARM64_LDSMAXAL, ARM64_LDSMAXL, ARM64_LDSMINB, ARM64_LDSMINAB, ARM64_LDSMINALB, ARM64_LDSMINLB,
+		ARM64_LDSMINH, ARM64_LDSMINAH, ARM64_LDSMINALH, ARM64_LDSMINLH, ARM64_LDSMIN, ARM64_LDSMINA,
+		ARM64_LDSMINAL, ARM64_LDSMINL, ARM64_LDUMAXB, ARM64_LDUMAXAB, ARM64_LDUMAXALB, ARM64_LDUMAXLB,
+		ARM64_LDUMAXH, ARM64_LDUMAXAH, ARM64_LDUMAXALH, ARM64_LDUMAXLH, ARM64_LDUMAX, ARM64_LDUMAXA,
+		ARM64_LDUMAXAL, ARM64_LDUMAXL, ARM64_LDUMINB, ARM64_LDUMINAB, ARM64_LDUMINALB, ARM64_LDUMINLB,
+		ARM64_LDUMINH, ARM64_LDUMINAH, ARM64_LDUMINALH, ARM64_LDUMINLH, ARM64_LDUMIN, ARM64_LDUMINA,
+		ARM64_LDUMINAL, ARM64_LDUMINL, ARM64_STADDLB, ARM64_STCLRLH, ARM64_STEORL, ARM64_STSETL,
+		ARM64_STSMAXB, ARM64_STSMINH, ARM64_STUMAX, ARM64_STUMIN, ARM64_STSMINL, ARM64_SWP, ARM64_SWPA,
+		ARM64_SWPAB, ARM64_SWPAH, ARM64_SWPALB, ARM64_SWPALH, ARM64_SWPB, ARM64_SWPH, ARM64_SWPL,
+		ARM64_SWPLB, ARM64_SWPLH, ARM64_SWPAL,
+	}},
+	{FEAT_RDM, []Operation{
+		ARM64_SQRDMLAH, ARM64_SQRDMLSH,
+	}},
+	{FEAT_LOR, []Operation{
+		ARM64_LDLARB, ARM64_LDLARH, ARM64_LDLAR, ARM64_STLLRB, ARM64_STLLRH, ARM64_STLLR,
+	}},
+	{FEAT_RAS, []Operation{
+		ARM64_ESB,
+	}},
+	{FEAT_SPE, []Operation{
+		ARM64_PSB,
+	}},
+	{FEAT_DotProd, []Operation{
+		ARM64_SDOT, ARM64_UDOT,
+	}},
+	{FEAT_FHM, []Operation{
+		ARM64_FMLAL, ARM64_FMLSL,
+	}},
+	{FEAT_SHA512, []Operation{
+		ARM64_SHA512H, ARM64_SHA512H2, ARM64_SHA512SU0, ARM64_SHA512SU1,
+	}},
+	{FEAT_SHA3, []Operation{
+		ARM64_RAX1, ARM64_BCAX, ARM64_EOR3, ARM64_XAR,
+	}},
+	{FEAT_SM3, []Operation{
+		ARM64_SM3PARTW1, ARM64_SM3PARTW2, ARM64_SM3SS1, ARM64_SM3TT1A, ARM64_SM3TT1B, ARM64_SM3TT2A,
+		ARM64_SM3TT2B,
+	}},
+	{FEAT_SM4, []Operation{
+		ARM64_SM4E, ARM64_SM4EKEY,
+	}},
+	{FEAT_PAuth, []Operation{
+		ARM64_AUTDA, ARM64_AUTDB, ARM64_AUTDZA, ARM64_AUTDZB, ARM64_AUTIA, ARM64_AUTIA1716,
+		ARM64_AUTIASP, ARM64_AUTIAZ, ARM64_AUTIB, ARM64_AUTIB1716, ARM64_AUTIBSP, ARM64_AUTIBZ,
+		ARM64_AUTIZA, ARM64_AUTIZB, ARM64_BLRAA, ARM64_BLRAAZ, ARM64_BLRAB, ARM64_BLRABZ, ARM64_BRAA,
+		ARM64_BRAAZ, ARM64_BRAB, ARM64_BRABZ, ARM64_ERETAA, ARM64_ERETAB, ARM64_LDRAA, ARM64_LDRAB,
+		ARM64_PACDA, ARM64_PACDB, ARM64_PACDZA, ARM64_PACDZB, ARM64_PACGA, ARM64_PACIA, ARM64_PACIA1716,
+		ARM64_PACIASP, ARM64_PACIAZ, ARM64_PACIB, ARM64_PACIB1716, ARM64_PACIBSP, ARM64_PACIBZ,
+		ARM64_PACIZA, ARM64_PACIZB, ARM64_RETAA, ARM64_RETAB, ARM64_XPACD, ARM64_XPACI, ARM64_XPACLRI,
+	}},
+	{FEAT_JSCVT, []Operation{
+		ARM64_FJCVTZS,
+	}},
+	{FEAT_FCMA, []Operation{
+		ARM64_FCADD, ARM64_FCMLA,
+	}},
+	{FEAT_LRCPC, []Operation{
+		ARM64_LDAPR, ARM64_LDAPRB, ARM64_LDAPRH,
+	}},
+	{FEAT_LRCPC2, []Operation{
+		ARM64_LDAPUR, ARM64_LDAPURB, ARM64_LDAPURH, ARM64_LDAPURSB, ARM64_LDAPURSH, ARM64_LDAPURSW,
+		ARM64_STLUR, ARM64_STLURB, ARM64_STLURH,
+	}},
+	{FEAT_FlagM, []Operation{
+		ARM64_CFINV, ARM64_RMIF, ARM64_SETF16, ARM64_SETF8,
+	}},
+	{FEAT_FlagM2, []Operation{
+		ARM64_AXFLAG, ARM64_XAFLAG,
+	}},
+	{FEAT_FRINTTS, []Operation{
+		ARM64_FRINT32X, ARM64_FRINT32Z, ARM64_FRINT64X, ARM64_FRINT64Z,
+	}},
+	{FEAT_SB, []Operation{
+		ARM64_SB,
+	}},
+	{FEAT_SPECRES, []Operation{
+		ARM64_CFP, ARM64_CPP, ARM64_DVP,
+	}},
+	{FEAT_BTI, []Operation{
+		ARM64_BTI,
+	}},
+	{FEAT_MTE, []Operation{
+		ARM64_ADDG, ARM64_CMPP, ARM64_GMI, ARM64_IRG, ARM64_LDG, ARM64_LDGM, ARM64_ST2G, ARM64_STG,
+		ARM64_STGM, ARM64_STGP, ARM64_STZ2G, ARM64_STZG, ARM64_STZGM, ARM64_SUBG, ARM64_SUBP,
+		ARM64_SUBPS,
+	}},
+	{FEAT_BF16, []Operation{
+		ARM64_BFCVT, ARM64_BFCVTN, ARM64_BFCVTN2, ARM64_BFDOT, ARM64_BFMLALB, ARM64_BFMLALT,
+		ARM64_BFMMLA,
+	}},
+	{FEAT_I8MM, []Operation{
+		ARM64_SMMLA, ARM64_SUDOT, ARM64_UMMLA, ARM64_USMMLA, ARM64_USDOT,
+	}},
+	{FEAT_LS64, []Operation{
+		ARM64_LD64B, ARM64_ST64B,
+	}},
+	{FEAT_LS64_V, []Operation{
+		ARM64_ST64BV,
+	}},
+	{FEAT_LS64_ACCDATA, []Operation{
+		ARM64_ST64BV0,
+	}},
+	{FEAT_WFxT, []Operation{
+		ARM64_WFET, ARM64_WFIT,
+	}},
+	{FEAT_MOPS, []Operation{
+		ARM64_CPYE, ARM64_CPYEN, ARM64_CPYERN, ARM64_CPYERT, ARM64_CPYERTN, ARM64_CPYERTRN,
+		ARM64_CPYERTWN, ARM64_CPYET, ARM64_CPYETN, ARM64_CPYETRN, ARM64_CPYETWN, ARM64_CPYEWN,
+		ARM64_CPYEWT, ARM64_CPYEWTN, ARM64_CPYEWTRN, ARM64_CPYEWTWN, ARM64_CPYFE, ARM64_CPYFEN,
+		ARM64_CPYFERN, ARM64_CPYFERT, ARM64_CPYFERTN, ARM64_CPYFERTRN, ARM64_CPYFERTWN, ARM64_CPYFET,
+		ARM64_CPYFETN, ARM64_CPYFETRN, ARM64_CPYFETWN, ARM64_CPYFEWN, ARM64_CPYFEWT, ARM64_CPYFEWTN,
+		ARM64_CPYFEWTRN, ARM64_CPYFEWTWN, ARM64_CPYFM, ARM64_CPYFMN, ARM64_CPYFMRN, ARM64_CPYFMRT,
+		ARM64_CPYFMRTN, ARM64_CPYFMRTRN, ARM64_CPYFMRTWN, ARM64_CPYFMT, ARM64_CPYFMTN, ARM64_CPYFMTRN,
+		ARM64_CPYFMTWN, ARM64_CPYFMWN, ARM64_CPYFMWT, ARM64_CPYFMWTN, ARM64_CPYFMWTRN, ARM64_CPYFMWTWN,
+		ARM64_CPYFP, ARM64_CPYFPN, ARM64_CPYFPRN, ARM64_CPYFPRT, ARM64_CPYFPRTN, ARM64_CPYFPRTRN,
+		ARM64_CPYFPRTWN, ARM64_CPYFPT, ARM64_CPYFPTN, ARM64_CPYFPTRN, ARM64_CPYFPTWN, ARM64_CPYFPWN,
+		ARM64_CPYFPWT, ARM64_CPYFPWTN, ARM64_CPYFPWTRN, ARM64_CPYFPWTWN, ARM64_CPYM, ARM64_CPYMN,
+		ARM64_CPYMRN, ARM64_CPYMRT, ARM64_CPYMRTN, ARM64_CPYMRTRN, ARM64_CPYMRTWN, ARM64_CPYMT,
+		ARM64_CPYMTN, ARM64_CPYMTRN, ARM64_CPYMTWN, ARM64_CPYMWN, ARM64_CPYMWT, ARM64_CPYMWTN,
+		ARM64_CPYMWTRN, ARM64_CPYMWTWN, ARM64_CPYP, ARM64_CPYPN, ARM64_CPYPRN, ARM64_CPYPRT,
+		ARM64_CPYPRTN, ARM64_CPYPRTRN, ARM64_CPYPRTWN, ARM64_CPYPT, ARM64_CPYPTN, ARM64_CPYPTRN,
+		ARM64_CPYPTWN, ARM64_CPYPWN, ARM64_CPYPWT, ARM64_CPYPWTN, ARM64_CPYPWTRN, ARM64_CPYPWTWN,
+		ARM64_SETE, ARM64_SETEN, ARM64_SETET, ARM64_SETETN, ARM64_SETGE, ARM64_SETGEN, ARM64_SETGET,
+		ARM64_SETGETN, ARM64_SETGM, ARM64_SETGMN, ARM64_SETGMT, ARM64_SETGMTN, ARM64_SETGP,
+		ARM64_SETGPN, ARM64_SETGPT, ARM64_SETGPTN, ARM64_SETM, ARM64_SETMN, ARM64_SETMT, ARM64_SETMTN,
+		ARM64_SETP, ARM64_SETPN, ARM64_SETPT, ARM64_SETPTN,
+	}},
+	{FEAT_CSSC, []Operation{
+		ARM64_CTZ,
+	}},
+	{FEAT_LRCPC3, []Operation{
+		ARM64_LDIAPP, ARM64_STILP,
+	}},
+	{FEAT_LSE128, []Operation{
+		ARM64_LDCLRP, ARM64_LDCLRPA, ARM64_LDCLRPAL, ARM64_LDCLRPL, ARM64_LDSETP, ARM64_LDSETPA,
+		ARM64_LDSETPAL, ARM64_LDSETPL, ARM64_SWPP, ARM64_SWPPA, ARM64_SWPPAL, ARM64_SWPPL,
+	}},
+	{FEAT_THE, []Operation{
+		ARM64_RCWCAS, ARM64_RCWCASA, ARM64_RCWCASAL, ARM64_RCWCASL, ARM64_RCWCASP, ARM64_RCWCASPA,
+		ARM64_RCWCASPAL, ARM64_RCWCASPL, ARM64_RCWCLR, ARM64_RCWCLRA, ARM64_RCWCLRAL, ARM64_RCWCLRL,
+		ARM64_RCWCLRP, ARM64_RCWCLRPA, ARM64_RCWCLRPAL, ARM64_RCWCLRPL, ARM64_RCWSCAS, ARM64_RCWSCASA,
+		ARM64_RCWSCASAL, ARM64_RCWSCASL, ARM64_RCWSCASP, ARM64_RCWSCASPA, ARM64_RCWSCASPAL,
+		ARM64_RCWSCASPL, ARM64_RCWSCLR, ARM64_RCWSCLRA, ARM64_RCWSCLRAL, ARM64_RCWSCLRL, ARM64_RCWSCLRP,
+		ARM64_RCWSCLRPA, ARM64_RCWSCLRPAL, ARM64_RCWSCLRPL, ARM64_RCWSET, ARM64_RCWSETA, ARM64_RCWSETAL,
+		ARM64_RCWSETL, ARM64_RCWSETP, ARM64_RCWSETPA, ARM64_RCWSETPAL, ARM64_RCWSETPL, ARM64_RCWSSET,
+		ARM64_RCWSSETA, ARM64_RCWSSETAL, ARM64_RCWSSETL, ARM64_RCWSSETP, ARM64_RCWSSETPA,
+		ARM64_RCWSSETPAL, ARM64_RCWSSETPL, ARM64_RCWSSWP, ARM64_RCWSSWPA, ARM64_RCWSSWPAL,
+		ARM64_RCWSSWPL, ARM64_RCWSSWPP, ARM64_RCWSSWPPA, ARM64_RCWSSWPPAL, ARM64_RCWSSWPPL,
+		ARM64_RCWSWP, ARM64_RCWSWPA, ARM64_RCWSWPAL, ARM64_RCWSWPL, ARM64_RCWSWPP, ARM64_RCWSWPPA,
+		ARM64_RCWSWPPAL, ARM64_RCWSWPPL,
+	}},
+	{FEAT_RPRFM, []Operation{
+		ARM64_RPRFM,
+	}},
+	{FEAT_SYSREG128, []Operation{
+		ARM64_MRRS, ARM64_MSRR,
+	}},
+	{FEAT_SYSINSTR128, []Operation{
+		ARM64_SYSP,
+	}},
+	{FEAT_GCS, []Operation{
+		ARM64_GCSSTR, ARM64_GCSSTTR, ARM64_GCSB, ARM64_GCSPOPCX, ARM64_GCSPOPM, ARM64_GCSPOPX,
+		ARM64_GCSPUSHM, ARM64_GCSPUSHX, ARM64_GCSSS1, ARM64_GCSSS2,
+	}},
+	{FEAT_TME, []Operation{
+		ARM64_TCANCEL, ARM64_TCOMMIT, ARM64_TSTART, ARM64_TTEST,
+	}},
+	{FEAT_BRBE, []Operation{
+		ARM64_BRB,
+	}},
+	{FEAT_SME, []Operation{
+		ARM64_ADDSPL, ARM64_ADDSVL, ARM64_RDSVL, ARM64_SMSTART, ARM64_SMSTOP,
+	}},
+})
+
+// sveFeatures maps the operations of the SVE group that need more than FEAT_SVE
+var sveFeatures = featureTable([]struct {
+	features   Features
+	operations []Operation
+}{
+	{FEAT_SVE2, []Operation{
+		ARM64_SQRDMLAH, ARM64_SQRDMLSH, ARM64_ADCLB, ARM64_ADCLT, ARM64_ADDHNB, ARM64_ADDHNT,
+		ARM64_CADD, ARM64_CDOT, ARM64_CMLA, ARM64_EORBT, ARM64_EORTB, ARM64_HISTCNT, ARM64_HISTSEG,
+		ARM64_MATCH, ARM64_NMATCH, ARM64_PMULLB, ARM64_PMULLT, ARM64_RADDHNB, ARM64_RADDHNT,
+		ARM64_RSHRNB, ARM64_RSHRNT, ARM64_RSUBHNB, ARM64_RSUBHNT, ARM64_SABALB, ARM64_SABALT,
+		ARM64_SABDLB, ARM64_SABDLT, ARM64_SADDLB, ARM64_SADDLBT, ARM64_SADDLT, ARM64_SADDWB,
+		ARM64_SADDWT, ARM64_SBCLB, ARM64_SBCLT, ARM64_SHRNB, ARM64_SHRNT, ARM64_SHSUBR, ARM64_SMLALB,
+		ARM64_SMLALT, ARM64_SMLSLB, ARM64_SMLSLT, ARM64_SMULLB, ARM64_SMULLT, ARM64_SQCADD,
+		ARM64_SQDMLALB, ARM64_SQDMLALBT, ARM64_SQDMLALT, ARM64_SQDMLSLB, ARM64_SQDMLSLBT,
+		ARM64_SQDMLSLT, ARM64_SQDMULLB, ARM64_SQDMULLT, ARM64_SQRDCMLAH, ARM64_SQRSHLR, ARM64_SQRSHRNB,
+		ARM64_SQRSHRNT, ARM64_SQRSHRUNB, ARM64_SQRSHRUNT, ARM64_SQSHLR, ARM64_SQSHRNB, ARM64_SQSHRNT,
+		ARM64_SQSHRUNB, ARM64_SQSHRUNT, ARM64_SQSUBR, ARM64_SQXTNB, ARM64_SQXTNT, ARM64_SQXTUNB,
+		ARM64_SQXTUNT, ARM64_SRSHLR, ARM64_SSHLLB, ARM64_SSHLLT, ARM64_SSUBLB, ARM64_SSUBLBT,
+		ARM64_SSUBLT, ARM64_SSUBLTB, ARM64_SSUBWB, ARM64_SSUBWT, ARM64_SUBHNB, ARM64_SUBHNT,
+		ARM64_UABALB, ARM64_UABALT, ARM64_UABDLB, ARM64_UABDLT, ARM64_UADDLB, ARM64_UADDLT,
+		ARM64_UADDWB, ARM64_UADDWT, ARM64_UHSUBR, ARM64_UMLALB, ARM64_UMLALT, ARM64_UMLSLB,
+		ARM64_UMLSLT, ARM64_UMULLB, ARM64_UMULLT, ARM64_UQRSHLR, ARM64_UQRSHRNB, ARM64_UQRSHRNT,
+		ARM64_UQSHLR, ARM64_UQSHRNB, ARM64_UQSHRNT, ARM64_UQSUBR, ARM64_UQXTNB, ARM64_UQXTNT,
+		ARM64_URSHLR, ARM64_USHLLB, ARM64_USHLLT, ARM64_USUBLB, ARM64_USUBLT, ARM64_USUBWB,
+		ARM64_USUBWT, ARM64_BCAX, ARM64_BSL1N, ARM64_BSL2N, ARM64_EOR3, ARM64_NBSL, ARM64_XAR,
+		ARM64_WHILEGE, ARM64_WHILEGT, ARM64_WHILEHI, ARM64_WHILEHS, ARM64_WHILERW, ARM64_WHILEWR,
+		ARM64_FCVTLT, ARM64_FCVTNT, ARM64_FMLALB, ARM64_FMLALT, ARM64_FMLSLB, ARM64_FMLSLT, ARM64_FCVTX,
+		ARM64_FLOGB, ARM64_LDNT1SB, ARM64_LDNT1SH, ARM64_LDNT1SW, ARM64_SADALP, ARM64_UADALP,
+		ARM64_SHADD, ARM64_UHADD, ARM64_SHSUB, ARM64_UHSUB, ARM64_SRHADD, ARM64_URHADD, ARM64_SQABS,
+		ARM64_SQNEG, ARM64_URECPE, ARM64_URSQRTE, ARM64_SUQADD, ARM64_USQADD, ARM64_SQSHL, ARM64_UQSHL,
+		ARM64_SRSHL, ARM64_URSHL, ARM64_SQRSHL, ARM64_UQRSHL, ARM64_SQSHLU, ARM64_SRSHR, ARM64_URSHR,
+		ARM64_SRSRA, ARM64_URSRA, ARM64_SSRA, ARM64_USRA, ARM64_SLI, ARM64_SRI, ARM64_ADDP, ARM64_SMAXP,
+		ARM64_SMINP, ARM64_UMAXP, ARM64_UMINP, ARM64_FADDP, ARM64_FMAXNMP, ARM64_FMAXP, ARM64_FMINNMP,
+		ARM64_FMINP, ARM64_FCVTXNT, ARM64_PMUL, ARM64_SQDMULH, ARM64_SQRDMULH, ARM64_SABA, ARM64_UABA,
+		ARM64_BSL, ARM64_TBX,
+	}},
+	{FEAT_SVE2p1, []Operation{
+		ARM64_SCLAMP, ARM64_SQCVTN, ARM64_SQCVTUN, ARM64_TBLQ, ARM64_UCLAMP, ARM64_UQCVTN, ARM64_UZPQ1,
+		ARM64_UZPQ2, ARM64_ZIPQ1, ARM64_ZIPQ2, ARM64_ADDQV, ARM64_ANDQV, ARM64_DUPQ, ARM64_EORQV,
+		ARM64_EXTQ, ARM64_ORQV, ARM64_PMOV, ARM64_REVD, ARM64_SMAXQV, ARM64_SMINQV, ARM64_TBXQ,
+		ARM64_UMAXQV, ARM64_UMINQV, ARM64_PEXT, ARM64_PSEL, ARM64_BFMLSLB, ARM64_BFMLSLT, ARM64_FADDQV,
+		ARM64_FCLAMP, ARM64_FDOT, ARM64_FMAXNMQV, ARM64_FMAXQV, ARM64_FMINNMQV, ARM64_FMINQV,
+		ARM64_LD1Q, ARM64_LD2Q, ARM64_LD3Q, ARM64_LD4Q, ARM64_ST1Q, ARM64_ST2Q, ARM64_ST3Q, ARM64_ST4Q,
+	}},
+	{FEAT_SVE_AES, []Operation{
+		ARM64_AESD, ARM64_AESE, ARM64_AESIMC, ARM64_AESMC,
+	}},
+	{FEAT_SVE_BitPerm, []Operation{
+		ARM64_BDEP, ARM64_BEXT, ARM64_BGRP,
+	}},
+	{FEAT_SVE_SHA3, []Operation{
+		ARM64_RAX1,
+	}},
+	{FEAT_SVE_SM4, []Operation{
+		ARM64_SM4E, ARM64_SM4EKEY,
+	}},
+	{FEAT_SME, []Operation{
+		ARM64_ADDSPL, ARM64_ADDSVL, ARM64_RDSVL,
+	}},
+})
+
+// fp16Encodings are the encodings of FEAT_FP16: the floating-point ones with
+// ftype == 11 and the half-precision Advanced SIMD ones. The conversions between
+// half and single or double precision, e.g. FCVT s0, h1, FCVTL and FCVTN, are
+// in the base architecture
+var fp16Encodings = []struct {
+	mask, value uint32
+}{
+	{0xfffe7c00, 0x1ee04000}, // FMOV, FABS, FNEG, FSQRT (register)
+	{0xfffc7c00, 0x1ee44000}, // FRINT<r> (scalar)
+	{0xffe0fc00, 0x1ee02000}, // FCMP, FCMPE
+	{0xffe01c00, 0x1ee01000}, // FMOV (scalar, immediate)
+	{0xffe00c00, 0x1ee00400}, // FCCMP, FCCMPE
+	{0xffe00c00, 0x1ee00800}, // floating-point data-processing (2 source)
+	{0xffe00c00, 0x1ee00c00}, // FCSEL
+	{0xffc00000, 0x1fc00000}, // floating-point data-processing (3 source)
+	{0x7fe0fc00, 0x1ee00000}, // conversion between floating-point and integer
+	{0x7fe00000, 0x1ec00000}, // conversion between floating-point and fixed-point
+	{0x9f60c400, 0x0e400400}, // Advanced SIMD three same (FP16)
+	{0x9f7e0c00, 0x0e780800}, // Advanced SIMD two-register miscellaneous (FP16)
+	{0xdf60c400, 0x5e400400}, // Advanced SIMD scalar three same (FP16)
+	{0xdf7e0c00, 0x5e780800}, // Advanced SIMD scalar two-register miscellaneous (FP16)
+	{0xff7fcc00, 0x5e30c800}, // FADDP, FMAXP, FMAXNMP, FMINP, FMINNMP (scalar)
+	{0xbf7fcc00, 0x0e30c800}, // FMAXV, FMAXNMV, FMINV, FMINNMV
+	{0xbff8fc00, 0x0f00fc00}, // FMOV (vector, immediate)
+	{0x9fc0f400, 0x0f001000}, // FMLA (by element)
+	{0x9fc0f400, 0x0f005000}, // FMLS (by element)
+	{0x9fc0f400, 0x0f009000}, // FMUL, FMULX (by element)
+	{0xdfc0f400, 0x5f001000}, // FMLA (scalar, by element)
+	{0xdfc0f400, 0x5f005000}, // FMLS (scalar, by element)
+	{0xdfc0f400, 0x5f009000}, // FMUL, FMULX (scalar, by element)
+	{0x9ff0fc00, 0x0f10e400}, // SCVTF, UCVTF (vector, fixed-point)
+	{0x9ff0fc00, 0x0f10fc00}, // FCVTZS, FCVTZU (vector, fixed-point)
+	{0xdff0fc00, 0x5f10e400}, // SCVTF, UCVTF (scalar, fixed-point)
+	{0xdff0fc00, 0x5f10fc00}, // FCVTZS, FCVTZU (scalar, fixed-point)
+	{0xbfe0e400, 0x2e40c400}, // FCMLA (vector)
+	{0xbfe0ec00, 0x2e40e400}, // FCADD
+	{0xbfc09400, 0x2f401000}, // FCMLA (by element)
+}
+
+// FeatureUsage is how often a feature is used by the instructions of a buffer
+type FeatureUsage struct {
+	Count int    // number of instructions needing the feature
+	First uint64 // address of the first of them
+}
+
+// FeatureReport scans the instructions of data, where data[0] is at
+// options.StartAddress, and returns the features they use. Words that fail to
+// decode are skipped; Options.Arch and Options.Features are ignored so that
+// every instruction is counted.
+func FeatureReport(data []byte, options Options) map[Features]FeatureUsage {
+	options.Arch, options.Features = ARCH_ANY, 0
+	report := make(map[Features]FeatureUsage)
+	d := NewDecoder(data, options)
+	for {
+		i, addr, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			continue
+		}
+		for _, f := range i.Features().List() {
+			u, ok := report[f]
+			if !ok {
+				u.First = addr
+			}
+			u.Count++
+			report[f] = u
+		}
+	}
+	return report
+}
@@ -0,0 +1,152 @@
+package arm64
+
+import "strings"
+
+//---------------------------------------------
+// Condition flags
+//---------------------------------------------
+
+// Flags is a set of the PSTATE.NZCV condition flags, laid out like the nzcv
+// immediate of CCMP and RMIF
+type Flags uint8
+
+const (
+	FLAG_V Flags = 1 << iota
+	FLAG_C
+	FLAG_Z
+	FLAG_N
+
+	FLAGS_NONE Flags = 0
+	FLAGS_NZCV       = FLAG_N | FLAG_Z | FLAG_C | FLAG_V
+)
+
+func (f Flags) String() string {
+	if f == FLAGS_NONE {
+		return "none"
+	}
+	var sb strings.Builder
+	for idx, name := range "NZCV" {
+		if f&(FLAG_N>>uint(idx)) != 0 {
+			sb.WriteRune(name)
+		}
+	}
+	return sb.String()
+}
+
+// Flags returns the condition flags c tests
+func (c Condition) Flags() Flags {
+	switch c {
+	case COND_EQ, COND_NE:
+		return FLAG_Z
+	case COND_CS, COND_CC:
+		return FLAG_C
+	case COND_MI, COND_PL:
+		return FLAG_N
+	case COND_VS, COND_VC:
+		return FLAG_V
+	case COND_HI, COND_LS:
+		return FLAG_C | FLAG_Z
+	case COND_GE, COND_LT:
+		return FLAG_N | FLAG_V
+	case COND_GT, COND_LE:
+		return FLAG_N | FLAG_Z | FLAG_V
+	}
+	return FLAGS_NONE
+}
+
+// branchConditions maps the B.cond operations to their condition
+var branchConditions = map[Operation]Condition{
+	ARM64_B_EQ: COND_EQ, ARM64_B_NE: COND_NE, ARM64_B_HS: COND_CS, ARM64_B_LO: COND_CC,
+	ARM64_B_MI: COND_MI, ARM64_B_PL: COND_PL, ARM64_B_VS: COND_VS, ARM64_B_VC: COND_VC,
+	ARM64_B_HI: COND_HI, ARM64_B_LS: COND_LS, ARM64_B_GE: COND_GE, ARM64_B_LT: COND_LT,
+	ARM64_B_GT: COND_GT, ARM64_B_LE: COND_LE, ARM64_B_AL: COND_AL, ARM64_B_NV: COND_NV,
+	ARM64_B_CS: COND_CS, ARM64_B_CC: COND_CC,
+}
+
+// flagsRead are the flags read by the operations that use them without a
+// CONDITION operand
+var flagsRead = map[Operation]Flags{
+	ARM64_ADC: FLAG_C, ARM64_ADCS: FLAG_C, ARM64_SBC: FLAG_C, ARM64_SBCS: FLAG_C,
+	ARM64_NGC: FLAG_C, ARM64_NGCS: FLAG_C,
+	ARM64_CFINV:   FLAG_C,
+	ARM64_AXFLAG:  FLAG_Z | FLAG_C | FLAG_V,
+	ARM64_XAFLAG:  FLAG_Z | FLAG_C,
+	ARM64_CTERMEQ: FLAG_C,
+	ARM64_CTERMNE: FLAG_C,
+}
+
+// flagsWritten are the flags set by the operations, RMIF, MSR NZCV and the
+// MOPS instructions depend on their operands and encoding
+var flagsWritten = map[Operation]Flags{
+	ARM64_ADDS: FLAGS_NZCV, ARM64_SUBS: FLAGS_NZCV, ARM64_ADCS: FLAGS_NZCV, ARM64_SBCS: FLAGS_NZCV,
+	ARM64_ANDS: FLAGS_NZCV, ARM64_BICS: FLAGS_NZCV, ARM64_NEGS: FLAGS_NZCV, ARM64_NGCS: FLAGS_NZCV,
+	ARM64_CMP: FLAGS_NZCV, ARM64_CMN: FLAGS_NZCV, ARM64_TST: FLAGS_NZCV,
+	ARM64_CCMN: FLAGS_NZCV, ARM64_CCMP: FLAGS_NZCV,
+	ARM64_FCMP: FLAGS_NZCV, ARM64_FCMPE: FLAGS_NZCV, ARM64_FCCMP: FLAGS_NZCV, ARM64_FCCMPE: FLAGS_NZCV,
+	ARM64_SUBPS: FLAGS_NZCV, ARM64_CMPP: FLAGS_NZCV,
+	ARM64_AXFLAG: FLAGS_NZCV, ARM64_XAFLAG: FLAGS_NZCV,
+	ARM64_CFINV: FLAG_C, ARM64_SETF8: FLAG_N | FLAG_Z | FLAG_V, ARM64_SETF16: FLAG_N | FLAG_Z | FLAG_V,
+	// SVE
+	ARM64_PTEST: FLAGS_NZCV, ARM64_PTRUES: FLAGS_NZCV, ARM64_PFIRST: FLAGS_NZCV, ARM64_PNEXT: FLAGS_NZCV,
+	ARM64_BRKAS: FLAGS_NZCV, ARM64_BRKBS: FLAGS_NZCV, ARM64_BRKNS: FLAGS_NZCV,
+	ARM64_BRKPAS: FLAGS_NZCV, ARM64_BRKPBS: FLAGS_NZCV,
+	ARM64_NANDS: FLAGS_NZCV, ARM64_NORS: FLAGS_NZCV, ARM64_ORNS: FLAGS_NZCV, ARM64_ORRS: FLAGS_NZCV,
+	ARM64_EORS: FLAGS_NZCV, ARM64_MOVS: FLAGS_NZCV, ARM64_NOTS: FLAGS_NZCV, ARM64_RDFFRS: FLAGS_NZCV,
+	ARM64_CTERMEQ: FLAG_N | FLAG_V, ARM64_CTERMNE: FLAG_N | FLAG_V,
+	ARM64_CMPEQ: FLAGS_NZCV, ARM64_CMPNE: FLAGS_NZCV, ARM64_CMPGE: FLAGS_NZCV, ARM64_CMPGT: FLAGS_NZCV,
+	ARM64_CMPHI: FLAGS_NZCV, ARM64_CMPHS: FLAGS_NZCV, ARM64_CMPLE: FLAGS_NZCV, ARM64_CMPLO: FLAGS_NZCV,
+	ARM64_CMPLS: FLAGS_NZCV, ARM64_CMPLT: FLAGS_NZCV, ARM64_MATCH: FLAGS_NZCV, ARM64_NMATCH: FLAGS_NZCV,
+	ARM64_WHILEGE: FLAGS_NZCV, ARM64_WHILEGT: FLAGS_NZCV, ARM64_WHILEHI: FLAGS_NZCV,
+	ARM64_WHILEHS: FLAGS_NZCV, ARM64_WHILELE: FLAGS_NZCV, ARM64_WHILELO: FLAGS_NZCV,
+	ARM64_WHILELS: FLAGS_NZCV, ARM64_WHILELT: FLAGS_NZCV, ARM64_WHILERW: FLAGS_NZCV,
+	ARM64_WHILEWR: FLAGS_NZCV,
+}
+
+// FlagsRead returns the condition flags i reads, those tested by its
+// condition, e.g. Z for B.EQ or CSEL ..., NE, and C for the carry in of ADC
+func (i *Instruction) FlagsRead() Flags {
+	if i.operation == ARM64_UNDEFINED {
+		return FLAGS_NONE
+	}
+	f := flagsRead[i.operation]
+	if c, ok := branchConditions[i.operation]; ok {
+		f |= c.Flags()
+	}
+	for _, op := range i.operands {
+		if op.OpClass == CONDITION {
+			f |= Condition(op.Reg[0]).Flags()
+		}
+	}
+	switch i.operation {
+	case ARM64_MRS:
+		if i.operands[1].OpClass == SYS_REG && SystemReg(i.operands[1].Reg[0]) == REG_NZCV {
+			f |= FLAGS_NZCV
+		}
+	}
+	switch i.MopsPhase() {
+	case MOPS_MAIN, MOPS_EPILOGUE:
+		f |= FLAGS_NZCV // the algorithm option chosen by the prologue
+	}
+	return f
+}
+
+// FlagsWritten returns the condition flags i sets, e.g. NZCV for ADDS, FCMP and
+// the SVE predicate tests, NZV for SETF8 and the mask of RMIF
+func (i *Instruction) FlagsWritten() Flags {
+	if i.operation == ARM64_UNDEFINED {
+		return FLAGS_NONE
+	}
+	f := flagsWritten[i.operation]
+	switch i.operation {
+	case ARM64_RMIF:
+		f |= Flags(i.operands[2].Immediate) & FLAGS_NZCV
+	case ARM64_MSR:
+		if i.operands[0].OpClass == SYS_REG && SystemReg(i.operands[0].Reg[0]) == REG_NZCV {
+			f |= FLAGS_NZCV
+		}
+	}
+	if i.MopsPhase() != MOPS_NONE {
+		f |= FLAGS_NZCV
+	}
+	return f
+}
@@ -0,0 +1,486 @@
+package arm64
+
+import "strings"
+
+//---------------------------------------------
+// Memory access descriptors
+//---------------------------------------------
+
+// AccessKind is how an instruction accesses memory
+type AccessKind uint32
+
+const (
+	ACCESS_NONE     AccessKind = iota
+	ACCESS_LOAD                // reads memory
+	ACCESS_STORE               // writes memory
+	ACCESS_RMW                 // atomically reads and writes memory, e.g. CAS, SWP or LDADD
+	ACCESS_PREFETCH            // hints a future access, e.g. PRFM
+)
+
+func (k AccessKind) String() string {
+	return []string{"none", "load", "store", "rmw", "prefetch"}[k]
+}
+
+// WritebackMode is when the base register of an access is updated
+type WritebackMode uint32
+
+const (
+	WRITEBACK_NONE WritebackMode = iota
+	WRITEBACK_PRE                // [<Xn>, #<imm>]!, the base is updated before the access
+	WRITEBACK_POST               // [<Xn>], #<imm>|<Xm>, the base is updated after the access
+)
+
+func (w WritebackMode) String() string {
+	return []string{"none", "pre", "post"}[w]
+}
+
+// MemoryOrder is a set of the ordering semantics of an access
+type MemoryOrder uint32
+
+const (
+	ORDER_EXCLUSIVE  MemoryOrder = 1 << iota // LDXR, STXR and friends
+	ORDER_ACQUIRE                            // LDAR, LDAXR and the A forms of the atomics
+	ORDER_ACQUIRE_PC                         // the weaker RCpc acquire of LDAPR and LDAPUR
+	ORDER_RELEASE                            // STLR, STLXR and the L forms of the atomics
+)
+
+func (o MemoryOrder) String() string {
+	if o == 0 {
+		return "none"
+	}
+	var names []string
+	for idx, name := range []string{"exclusive", "acquire", "acquire_pc", "release"} {
+		if o&(1<<uint(idx)) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// MemoryAccess describes the memory access of a load, store, atomic or
+// prefetch instruction. The address is Base plus Offset, or plus Index with
+// Extend and Shift applied; for WRITEBACK_POST the access is at Base and
+// Offset or Index is added to Base afterwards. The SVE and SME accesses that
+// depend on the vector length are Scalable: they access Size bytes for every
+// 128 bits of it, e.g. 16 for LDR z0 or 8 for LD1W {z0.d}.
+type MemoryAccess struct {
+	Kind       AccessKind
+	Base       Register      // REG_NONE for a PC relative literal load, a Z register for SVE vector bases
+	Index      Register      // REG_NONE when the offset is an immediate
+	Extend     ShiftType     // applied to Index, e.g. SHIFT_LSL, SHIFT_UXTW or SHIFT_SXTX
+	Shift      uint32        // left shift amount of Index
+	Offset     int64         // immediate offset, from the instruction's address for a literal load
+	MulVl      bool          // Offset is in multiples of the SVE vector length
+	PCRelative bool          // a literal load, Offset is from the instruction's address
+	Writeback  WritebackMode // how Base is updated
+	Size       uint32        // bytes accessed, 0 when it is implementation defined, e.g. for LDGM
+	Scalable   bool          // Size is per 128 bits of the SVE or SME vector length
+	Order      MemoryOrder
+	Tag        bool // accesses the MTE allocation tags, Size is then the bytes they cover
+}
+
+// memInfo is how an operation accesses memory
+type memInfo struct {
+	kind  AccessKind
+	order MemoryOrder
+	tag   bool
+	size  uint32 // fixed access size, 0 when it follows the data registers
+	elem  uint32 // size of each data register or SVE element in memory, 0 when it is the register size
+	pair  bool   // accesses a pair of data registers, e.g. LDP, STXP or CASP
+}
+
+func memTable(table []struct {
+	info       memInfo
+	operations []Operation
+}) map[Operation]memInfo {
+	m := make(map[Operation]memInfo)
+	for _, t := range table {
+		for _, op := range t.operations {
+			m[op] = t.info
+		}
+	}
+	return m
+}
+
+// memInfos maps the loads, stores, atomics and prefetches to how they access
+// memory. The operations that are not listed do not access memory
+var memInfos = memTable([]struct {
+	info       memInfo
+	operations []Operation
+}{
+	{memInfo{kind: ACCESS_LOAD}, []Operation{
+		ARM64_LD1, ARM64_LD1R, ARM64_LD2, ARM64_LD2R, ARM64_LD3, ARM64_LD3R, ARM64_LD4, ARM64_LD4R,
+		ARM64_LDR, ARM64_LDRAA, ARM64_LDRAB, ARM64_LDTR, ARM64_LDUR,
+	}},
+	{memInfo{kind: ACCESS_LOAD, elem: 1}, []Operation{
+		ARM64_LD1B, ARM64_LD1RB, ARM64_LD1ROB, ARM64_LD1RQB, ARM64_LD1RSB, ARM64_LD1SB, ARM64_LD2B,
+		ARM64_LD3B, ARM64_LD4B, ARM64_LDFF1B, ARM64_LDFF1SB, ARM64_LDNF1B, ARM64_LDNF1SB,
+		ARM64_LDNT1B, ARM64_LDNT1SB, ARM64_LDRB, ARM64_LDRSB, ARM64_LDTRB, ARM64_LDTRSB,
+		ARM64_LDURB, ARM64_LDURSB,
+	}},
+	{memInfo{kind: ACCESS_LOAD, elem: 2}, []Operation{
+		ARM64_LD1H, ARM64_LD1RH, ARM64_LD1ROH, ARM64_LD1RQH, ARM64_LD1RSH, ARM64_LD1SH, ARM64_LD2H,
+		ARM64_LD3H, ARM64_LD4H, ARM64_LDFF1H, ARM64_LDFF1SH, ARM64_LDNF1H, ARM64_LDNF1SH,
+		ARM64_LDNT1H, ARM64_LDNT1SH, ARM64_LDRH, ARM64_LDRSH, ARM64_LDTRH, ARM64_LDTRSH,
+		ARM64_LDURH, ARM64_LDURSH,
+	}},
+	{memInfo{kind: ACCESS_LOAD, elem: 4}, []Operation{
+		ARM64_LD1ROW, ARM64_LD1RQW, ARM64_LD1RW, ARM64_LD1W, ARM64_LD2W, ARM64_LD3W, ARM64_LD4W,
+		ARM64_LDFF1W, ARM64_LDNF1W, ARM64_LDNT1W, ARM64_LD1RSW, ARM64_LD1SW, ARM64_LDFF1SW,
+		ARM64_LDNF1SW, ARM64_LDNT1SW, ARM64_LDRSW, ARM64_LDTRSW, ARM64_LDURSW,
+	}},
+	{memInfo{kind: ACCESS_LOAD, elem: 8}, []Operation{
+		ARM64_LD1D, ARM64_LD1RD, ARM64_LD1ROD, ARM64_LD1RQD, ARM64_LD2D, ARM64_LD3D, ARM64_LD4D,
+		ARM64_LDFF1D, ARM64_LDNF1D, ARM64_LDNT1D,
+	}},
+	{memInfo{kind: ACCESS_LOAD, elem: 16}, []Operation{
+		ARM64_LD1Q, ARM64_LD2Q, ARM64_LD3Q, ARM64_LD4Q,
+	}},
+	{memInfo{kind: ACCESS_LOAD, pair: true}, []Operation{
+		ARM64_LDNP, ARM64_LDP,
+	}},
+	{memInfo{kind: ACCESS_LOAD, elem: 4, pair: true}, []Operation{
+		ARM64_LDPSW,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_ACQUIRE}, []Operation{
+		ARM64_LDAR, ARM64_LDLAR,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_ACQUIRE, elem: 1}, []Operation{
+		ARM64_LDARB, ARM64_LDLARB,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_ACQUIRE, elem: 2}, []Operation{
+		ARM64_LDARH, ARM64_LDLARH,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_ACQUIRE_PC}, []Operation{
+		ARM64_LDAPR, ARM64_LDAPUR,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_ACQUIRE_PC, elem: 1}, []Operation{
+		ARM64_LDAPRB, ARM64_LDAPURB, ARM64_LDAPURSB,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_ACQUIRE_PC, elem: 2}, []Operation{
+		ARM64_LDAPRH, ARM64_LDAPURH, ARM64_LDAPURSH,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_ACQUIRE_PC, elem: 4}, []Operation{
+		ARM64_LDAPURSW,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_ACQUIRE_PC, pair: true}, []Operation{
+		ARM64_LDIAPP,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_EXCLUSIVE}, []Operation{
+		ARM64_LDXR,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_EXCLUSIVE, elem: 1}, []Operation{
+		ARM64_LDXRB,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_EXCLUSIVE, elem: 2}, []Operation{
+		ARM64_LDXRH,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_EXCLUSIVE, pair: true}, []Operation{
+		ARM64_LDXP,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_EXCLUSIVE | ORDER_ACQUIRE}, []Operation{
+		ARM64_LDAXR,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_EXCLUSIVE | ORDER_ACQUIRE, elem: 1}, []Operation{
+		ARM64_LDAXRB,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_EXCLUSIVE | ORDER_ACQUIRE, elem: 2}, []Operation{
+		ARM64_LDAXRH,
+	}},
+	{memInfo{kind: ACCESS_LOAD, order: ORDER_EXCLUSIVE | ORDER_ACQUIRE, pair: true}, []Operation{
+		ARM64_LDAXP,
+	}},
+	{memInfo{kind: ACCESS_LOAD, size: 64}, []Operation{
+		ARM64_LD64B,
+	}},
+	{memInfo{kind: ACCESS_LOAD, tag: true}, []Operation{
+		ARM64_LDGM,
+	}},
+	{memInfo{kind: ACCESS_LOAD, tag: true, size: 16}, []Operation{
+		ARM64_LDG,
+	}},
+	{memInfo{kind: ACCESS_STORE}, []Operation{
+		ARM64_GCSSTR, ARM64_GCSSTTR, ARM64_ST1, ARM64_ST2, ARM64_ST3, ARM64_ST4, ARM64_STR,
+		ARM64_STTR, ARM64_STUR,
+	}},
+	{memInfo{kind: ACCESS_STORE, elem: 1}, []Operation{
+		ARM64_ST1B, ARM64_ST2B, ARM64_ST3B, ARM64_ST4B, ARM64_STNT1B, ARM64_STRB, ARM64_STTRB,
+		ARM64_STURB,
+	}},
+	{memInfo{kind: ACCESS_STORE, elem: 2}, []Operation{
+		ARM64_ST1H, ARM64_ST2H, ARM64_ST3H, ARM64_ST4H, ARM64_STNT1H, ARM64_STRH, ARM64_STTRH,
+		ARM64_STURH,
+	}},
+	{memInfo{kind: ACCESS_STORE, elem: 4}, []Operation{
+		ARM64_ST1W, ARM64_ST2W, ARM64_ST3W, ARM64_ST4W, ARM64_STNT1W,
+	}},
+	{memInfo{kind: ACCESS_STORE, elem: 8}, []Operation{
+		ARM64_ST1D, ARM64_ST2D, ARM64_ST3D, ARM64_ST4D, ARM64_STNT1D,
+	}},
+	{memInfo{kind: ACCESS_STORE, elem: 16}, []Operation{
+		ARM64_ST1Q, ARM64_ST2Q, ARM64_ST3Q, ARM64_ST4Q,
+	}},
+	{memInfo{kind: ACCESS_STORE, pair: true}, []Operation{
+		ARM64_STNP, ARM64_STP,
+	}},
+	{memInfo{kind: ACCESS_STORE, order: ORDER_EXCLUSIVE}, []Operation{
+		ARM64_STXR,
+	}},
+	{memInfo{kind: ACCESS_STORE, order: ORDER_EXCLUSIVE, elem: 1}, []Operation{
+		ARM64_STXRB,
+	}},
+	{memInfo{kind: ACCESS_STORE, order: ORDER_EXCLUSIVE, elem: 2}, []Operation{
+		ARM64_STXRH,
+	}},
+	{memInfo{kind: ACCESS_STORE, order: ORDER_EXCLUSIVE, pair: true}, []Operation{
+		ARM64_STXP,
+	}},
+	{memInfo{kind: ACCESS_STORE, order: ORDER_EXCLUSIVE | ORDER_RELEASE}, []Operation{
+		ARM64_STLXR,
+	}},
+	{memInfo{kind: ACCESS_STORE, order: ORDER_EXCLUSIVE | ORDER_RELEASE, elem: 1}, []Operation{
+		ARM64_STLXRB,
+	}},
+	{memInfo{kind: ACCESS_STORE, order: ORDER_EXCLUSIVE | ORDER_RELEASE, elem: 2}, []Operation{
+		ARM64_STLXRH,
+	}},
+	{memInfo{kind: ACCESS_STORE, order: ORDER_EXCLUSIVE | ORDER_RELEASE, pair: true}, []Operation{
+		ARM64_STLXP,
+	}},
+	{memInfo{kind: ACCESS_STORE, order: ORDER_RELEASE}, []Operation{
+		ARM64_STLLR, ARM64_STLR, ARM64_STLUR,
+	}},
+	{memInfo{kind: ACCESS_STORE, order: ORDER_RELEASE, elem: 1}, []Operation{
+		ARM64_STLLRB, ARM64_STLRB, ARM64_STLURB,
+	}},
+	{memInfo{kind: ACCESS_STORE, order: ORDER_RELEASE, elem: 2}, []Operation{
+		ARM64_STLLRH, ARM64_STLRH, ARM64_STLURH,
+	}},
+	{memInfo{kind: ACCESS_STORE, order: ORDER_RELEASE, pair: true}, []Operation{
+		ARM64_STILP,
+	}},
+	{memInfo{kind: ACCESS_STORE, size: 64}, []Operation{
+		ARM64_ST64BV, ARM64_ST64BV0, ARM64_ST64B,
+	}},
+	{memInfo{kind: ACCESS_STORE, tag: true}, []Operation{
+		ARM64_STGM, ARM64_STZGM,
+	}},
+	{memInfo{kind: ACCESS_STORE, tag: true, size: 16}, []Operation{
+		ARM64_STG, ARM64_STGP, ARM64_STZG,
+	}},
+	{memInfo{kind: ACCESS_STORE, tag: true, size: 32}, []Operation{
+		ARM64_ST2G, ARM64_STZ2G,
+	}},
+	{memInfo{kind: ACCESS_RMW}, []Operation{
+		ARM64_CAS, ARM64_LDADD, ARM64_LDCLR, ARM64_LDEOR, ARM64_LDSET, ARM64_LDSMAX, ARM64_LDSMIN,
+		ARM64_LDUMAX, ARM64_LDUMIN, ARM64_RCWCAS, ARM64_RCWCLR, ARM64_RCWSCAS, ARM64_RCWSCLR,
+		ARM64_RCWSET, ARM64_RCWSSET, ARM64_RCWSSWP, ARM64_RCWSWP, ARM64_STUMAX, ARM64_STUMIN,
+		ARM64_SWP,
+	}},
+	{memInfo{kind: ACCESS_RMW, elem: 1}, []Operation{
+		ARM64_CASB, ARM64_LDADDB, ARM64_LDCLRB, ARM64_LDEORB, ARM64_LDSETB, ARM64_LDSMAXB,
+		ARM64_LDSMINB, ARM64_LDUMAXB, ARM64_LDUMINB, ARM64_STSMAXB, ARM64_SWPB,
+	}},
+	{memInfo{kind: ACCESS_RMW, elem: 2}, []Operation{
+		ARM64_CASH, ARM64_LDADDH, ARM64_LDCLRH, ARM64_LDEORH, ARM64_LDSETH, ARM64_LDSMAXH,
+		ARM64_LDSMINH, ARM64_LDUMAXH, ARM64_LDUMINH, ARM64_STSMINH, ARM64_SWPH,
+	}},
+	{memInfo{kind: ACCESS_RMW, pair: true}, []Operation{
+		ARM64_CASP, ARM64_LDCLRP, ARM64_LDSETP, ARM64_RCWCASP, ARM64_RCWCLRP, ARM64_RCWSCASP,
+		ARM64_RCWSCLRP, ARM64_RCWSETP, ARM64_RCWSSETP, ARM64_RCWSSWPP, ARM64_RCWSWPP, ARM64_SWPP,
+	}},
+	{memInfo{kind: ACCESS_RMW, order: ORDER_ACQUIRE}, []Operation{
+		ARM64_CASA, ARM64_LDADDA, ARM64_LDCLRA, ARM64_LDEORA, ARM64_LDSETA, ARM64_LDSMAXA,
+		ARM64_LDSMINA, ARM64_LDUMAXA, ARM64_LDUMINA, ARM64_RCWCASA, ARM64_RCWCLRA, ARM64_RCWSCASA,
+		ARM64_RCWSCLRA, ARM64_RCWSETA, ARM64_RCWSSETA, ARM64_RCWSSWPA, ARM64_RCWSWPA, ARM64_SWPA,
+	}},
+	{memInfo{kind: ACCESS_RMW, order: ORDER_ACQUIRE, elem: 1}, []Operation{
+		ARM64_CASAB, ARM64_LDADDAB, ARM64_LDCLRAB, ARM64_LDEORAB, ARM64_LDSETAB, ARM64_LDSMAXAB,
+		ARM64_LDSMINAB, ARM64_LDUMAXAB, ARM64_LDUMINAB, ARM64_SWPAB,
+	}},
+	{memInfo{kind: ACCESS_RMW, order: ORDER_ACQUIRE, elem: 2}, []Operation{
+		ARM64_CASAH, ARM64_LDADDAH, ARM64_LDCLRAH, ARM64_LDEORAH, ARM64_LDSETAH, ARM64_LDSMAXAH,
+		ARM64_LDSMINAH, ARM64_LDUMAXAH, ARM64_LDUMINAH, ARM64_SWPAH,
+	}},
+	{memInfo{kind: ACCESS_RMW, order: ORDER_ACQUIRE, pair: true}, []Operation{
+		ARM64_CASPA, ARM64_LDCLRPA, ARM64_LDSETPA, ARM64_RCWCASPA, ARM64_RCWCLRPA, ARM64_RCWSCASPA,
+		ARM64_RCWSCLRPA, ARM64_RCWSETPA, ARM64_RCWSSETPA, ARM64_RCWSSWPPA, ARM64_RCWSWPPA,
+		ARM64_SWPPA,
+	}},
+	{memInfo{kind: ACCESS_RMW, order: ORDER_ACQUIRE | ORDER_RELEASE}, []Operation{
+		ARM64_CASAL, ARM64_LDADDAL, ARM64_LDCLRAL, ARM64_LDEORAL, ARM64_LDSETAL, ARM64_LDSMAXAL,
+		ARM64_LDSMINAL, ARM64_LDUMAXAL, ARM64_LDUMINAL, ARM64_RCWCASAL, ARM64_RCWCLRAL,
+		ARM64_RCWSCASAL, ARM64_RCWSCLRAL, ARM64_RCWSETAL, ARM64_RCWSSETAL, ARM64_RCWSSWPAL,
+		ARM64_RCWSWPAL, ARM64_SWPAL,
+	}},
+	{memInfo{kind: ACCESS_RMW, order: ORDER_ACQUIRE | ORDER_RELEASE, elem: 1}, []Operation{
+		ARM64_CASALB, ARM64_LDADDALB, ARM64_LDCLRALB, ARM64_LDEORALB, ARM64_LDSETALB,
+		ARM64_LDSMAXALB, ARM64_LDSMINALB, ARM64_LDUMAXALB, ARM64_LDUMINALB, ARM64_SWPALB,
+	}},
+	{memInfo{kind: ACCESS_RMW, order: ORDER_ACQUIRE | ORDER_RELEASE, elem: 2}, []Operation{
+		ARM64_CASALH, ARM64_LDADDALH, ARM64_LDCLRALH, ARM64_LDEORALH, ARM64_LDSETALH,
+		ARM64_LDSMAXALH, ARM64_LDSMINALH, ARM64_LDUMAXALH, ARM64_LDUMINALH, ARM64_SWPALH,
+	}},
+	{memInfo{kind: ACCESS_RMW, order: ORDER_ACQUIRE | ORDER_RELEASE, pair: true}, []Operation{
+		ARM64_CASPAL, ARM64_LDCLRPAL, ARM64_LDSETPAL, ARM64_RCWCASPAL, ARM64_RCWCLRPAL,
+		ARM64_RCWSCASPAL, ARM64_RCWSCLRPAL, ARM64_RCWSETPAL, ARM64_RCWSSETPAL, ARM64_RCWSSWPPAL,
+		ARM64_RCWSWPPAL, ARM64_SWPPAL,
+	}},
+	{memInfo{kind: ACCESS_RMW, order: ORDER_RELEASE}, []Operation{
+		ARM64_CASL, ARM64_LDADDL, ARM64_LDCLRL, ARM64_LDEORL, ARM64_LDSETL, ARM64_LDSMAXL,
+		ARM64_LDSMINL, ARM64_LDUMAXL, ARM64_LDUMINL, ARM64_RCWCASL, ARM64_RCWCLRL, ARM64_RCWSCASL,
+		ARM64_RCWSCLRL, ARM64_RCWSETL, ARM64_RCWSSETL, ARM64_RCWSSWPL, ARM64_RCWSWPL, ARM64_STEORL,
+		ARM64_STSETL, ARM64_STSMINL, ARM64_SWPL,
+	}},
+	{memInfo{kind: ACCESS_RMW, order: ORDER_RELEASE, elem: 1}, []Operation{
+		ARM64_CASLB, ARM64_LDADDLB, ARM64_LDCLRLB, ARM64_LDEORLB, ARM64_LDSETLB, ARM64_LDSMAXLB,
+		ARM64_LDSMINLB, ARM64_LDUMAXLB, ARM64_LDUMINLB, ARM64_STADDLB, ARM64_SWPLB,
+	}},
+	{memInfo{kind: ACCESS_RMW, order: ORDER_RELEASE, elem: 2}, []Operation{
+		ARM64_CASLH, ARM64_LDADDLH, ARM64_LDCLRLH, ARM64_LDEORLH, ARM64_LDSETLH, ARM64_LDSMAXLH,
+		ARM64_LDSMINLH, ARM64_LDUMAXLH, ARM64_LDUMINLH, ARM64_STCLRLH, ARM64_SWPLH,
+	}},
+	{memInfo{kind: ACCESS_RMW, order: ORDER_RELEASE, pair: true}, []Operation{
+		ARM64_CASPL, ARM64_LDCLRPL, ARM64_LDSETPL, ARM64_RCWCASPL, ARM64_RCWCLRPL, ARM64_RCWSCASPL,
+		ARM64_RCWSCLRPL, ARM64_RCWSETPL, ARM64_RCWSSETPL, ARM64_RCWSSWPPL, ARM64_RCWSWPPL,
+		ARM64_SWPPL,
+	}},
+	{memInfo{kind: ACCESS_PREFETCH}, []Operation{
+		ARM64_PRFB, ARM64_PRFD, ARM64_PRFH, ARM64_PRFM, ARM64_PRFUM, ARM64_PRFW, ARM64_RPRFM,
+	}},
+})
+
+// MemoryAccess returns the memory access of i, or false when i does not
+// access memory. The MOPS CPY* and SET* sequences access a range given by
+// registers and are not described.
+func (i *Instruction) MemoryAccess() (MemoryAccess, bool) {
+	info, ok := memInfos[i.operation]
+	if !ok || i.MopsPhase() != MOPS_NONE {
+		return MemoryAccess{}, false
+	}
+	access := MemoryAccess{Kind: info.kind, Order: info.order, Tag: info.tag}
+
+	mem := -1
+	for idx, op := range i.operands {
+		switch op.OpClass {
+		case MEM_REG, MEM_OFFSET, MEM_EXTENDED, MEM_PRE_IDX, MEM_POST_IDX:
+			mem = idx
+			access.Base = Register(op.Reg[0])
+			access.Offset = int64(op.Immediate)
+			access.MulVl = op.MulVl
+			switch op.OpClass {
+			case MEM_EXTENDED:
+				access.Index = Register(op.Reg[1])
+				access.Extend = op.ShiftType
+				access.Shift = op.ShiftValue
+			case MEM_PRE_IDX:
+				access.Writeback = WRITEBACK_PRE
+			case MEM_POST_IDX:
+				access.Writeback = WRITEBACK_POST
+				access.Index = Register(op.Reg[1])
+			}
+		case LABEL:
+			if info.kind == ACCESS_LOAD || info.kind == ACCESS_PREFETCH {
+				mem = idx
+				access.PCRelative = true
+				access.Offset = int64(op.Immediate - i.address)
+			}
+		}
+		if mem >= 0 {
+			break
+		}
+	}
+	if mem < 0 {
+		return MemoryAccess{}, false
+	}
+	if access.Kind != ACCESS_PREFETCH {
+		access.Size, access.Scalable = i.accessSize(info, mem)
+	}
+	return access, true
+}
+
+// accessSize returns the bytes accessed by i whose memory operand is operand
+// mem, from the data registers before it, and whether they are per 128 bits of
+// the vector length
+func (i *Instruction) accessSize(info memInfo, mem int) (uint32, bool) {
+	if info.size != 0 || info.tag {
+		return info.size, false
+	}
+	if mem == 0 {
+		return 0, false
+	}
+	data := i.operands[mem-1]
+	if r := Register(data.Reg[0]); mem > 1 && data.OpClass == REG && r >= REG_P0 && r <= REG_PN15 {
+		// the governing predicate of an SVE or SME load or store
+		if list := i.operands[mem-2]; list.OpClass == MULTI_REG {
+			data = list
+		}
+	}
+	switch data.OpClass {
+	case MULTI_REG:
+		return i.structureSize(info, data)
+	case SME_TILE:
+		return 16, true // LDR and STR of a ZA array vector
+	}
+	if data.OpClass != REG {
+		return 0, false
+	}
+	r := Register(data.Reg[0])
+	switch {
+	case r >= REG_Z0 && r <= REG_Z31:
+		return 16, true // LDR and STR of a whole SVE vector
+	case r >= REG_P0 && r <= REG_P15:
+		return 2, true // LDR and STR of a whole SVE predicate
+	case r == REG_ZT0:
+		return 64, false
+	}
+	size := getRegisterSize(r)
+	if info.elem != 0 {
+		size = info.elem
+	}
+	if info.pair {
+		return 2 * size, false
+	}
+	return size, false
+}
+
+// structureSize returns the bytes accessed by an LD1..LD4 or ST1..ST4 of the
+// register list op, per 128 bits of the vector length for the SVE and SME forms
+// that depend on it
+func (i *Instruction) structureSize(info memInfo, op InstructionOperand) (uint32, bool) {
+	var regs uint32
+	for _, r := range op.Reg {
+		if Register(r) != REG_NONE {
+			regs++
+		}
+	}
+	first := Register(op.Reg[0])
+	if first >= REG_Z0 && first <= REG_Z31 {
+		// SVE load and replicate a single element, quadword or octaword
+		switch i.operation {
+		case ARM64_LD1RQB, ARM64_LD1RQH, ARM64_LD1RQW, ARM64_LD1RQD:
+			return 16, false
+		case ARM64_LD1ROB, ARM64_LD1ROH, ARM64_LD1ROW, ARM64_LD1ROD:
+			return 32, false
+		case ARM64_LD1RB, ARM64_LD1RH, ARM64_LD1RW, ARM64_LD1RD, ARM64_LD1RSB, ARM64_LD1RSH, ARM64_LD1RSW:
+			return info.elem, false
+		}
+		if info.elem == 0 || op.ElementSize == 0 {
+			return 0, false
+		}
+		// an element of elem bytes for each element of the registers, the
+		// contiguous, gather and scatter forms alike
+		return regs * (16 / op.ElementSize) * info.elem, true
+	}
+	if first >= REG_ZA && first <= REG_ZA15 {
+		return 16, true // a ZA tile slice is one vector length
+	}
+	switch i.operation {
+	case ARM64_LD1R, ARM64_LD2R, ARM64_LD3R, ARM64_LD4R:
+		return regs * op.ElementSize, false
+	}
+	if op.DataSize == 0 {
+		return regs * op.ElementSize, false // a single element of each register
+	}
+	return regs * op.DataSize * op.ElementSize, false
+}
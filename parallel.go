@@ -0,0 +1,67 @@
+package arm64
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+)
+
+// parallelChunkWords is the number of instruction words a DisassembleParallel
+// worker decodes at a time
+const parallelChunkWords = 4 * 1024
+
+// DisassembleParallel disassembles the little-endian words of buf, where
+// buf[0] is at base, with up to workers goroutines (GOMAXPROCS when
+// workers <= 0). AArch64 instructions are fixed width, so the buffer is split
+// into chunks that are decoded concurrently. The results are in address order;
+// a trailing partial word is reported by a final Result with an Error.
+func DisassembleParallel(buf []byte, base uint64, workers int) []Result {
+	return DisassembleParallelOptions(buf, Options{StartAddress: int64(base)}, workers)
+}
+
+// DisassembleParallelOptions is DisassembleParallel that takes Options, where
+// buf[0] is at options.StartAddress. The results match what Disassemble
+// outputs with the same options, except when StartAddress is 0: Disassemble
+// then reports every word at address 0, while each result here keeps its
+// offset into buf as its address
+func DisassembleParallelOptions(buf []byte, options Options, workers int) []Result {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	base := uint64(options.StartAddress)
+	order := options.byteOrder()
+
+	count := len(buf) / 4
+	results := make([]Result, count, count+1)
+
+	chunks := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for start := range chunks {
+				end := start + parallelChunkWords
+				if end > count {
+					end = count
+				}
+				for idx := start; idx < end; idx++ {
+					results[idx] = disassembleWord(order.Uint32(buf[4*idx:]), base+uint64(4*idx), options)
+				}
+			}
+		}()
+	}
+	for start := 0; start < count; start += parallelChunkWords {
+		chunks <- start
+	}
+	close(chunks)
+	wg.Wait()
+
+	if len(buf)%4 != 0 {
+		results = append(results, Result{
+			Error: fmt.Errorf("failed to read instruction: %v", io.ErrUnexpectedEOF),
+		})
+	}
+	return results
+}
@@ -0,0 +1,346 @@
+package arm64
+
+//---------------------------------------------
+// Register read/write sets
+//---------------------------------------------
+
+// Canonical returns the full architectural register r is a view of: Xn for Wn,
+// SP for WSP, Vn for Bn, Hn, Sn, Dn and Qn, Pn for PNn and ZA for its tiles.
+// The zero registers are not storage and return REG_NONE. Zn is not folded
+// into Vn as it is wider when SVE is implemented.
+func (r Register) Canonical() Register {
+	switch {
+	case r >= REG_W0 && r <= REG_W30:
+		return REG_X0 + (r - REG_W0)
+	case r == REG_WSP:
+		return REG_SP
+	case r == REG_WZR || r == REG_XZR:
+		return REG_NONE
+	case r >= REG_V0 && r <= REG_Q31:
+		// each of the V, B, H, S, D and Q sets is n0..n30, nZR, n31
+		n := (r - REG_V0) % (REG_B0 - REG_V0)
+		switch n {
+		case 31:
+			return REG_NONE
+		case 32:
+			return REG_V31
+		}
+		return REG_V0 + n
+	case r >= REG_PN0 && r <= REG_PN15:
+		return REG_P0 + (r - REG_PN0)
+	case r >= REG_ZA0 && r <= REG_ZA15:
+		return REG_ZA
+	}
+	return r
+}
+
+// isStorage reports whether the canonical register r holds state, as opposed
+// to a prefetch operation or an immediate printed as a register
+func (r Register) isStorage() bool {
+	return (r >= REG_X0 && r <= REG_SP && r != REG_XZR) ||
+		(r >= REG_V0 && r <= REG_V31) ||
+		(r >= REG_Z0 && r <= REG_P15) ||
+		r == REG_ZA || r == REG_ZT0 || r == REG_FLAGS || r == REG_FFR
+}
+
+// regShape is how an operation uses its explicit register operands
+type regShape uint8
+
+const (
+	shapeDest           regShape = iota // the first operand is written, the others are read
+	shapeNoDest                         // every operand is read
+	shapeAccumulate                     // the first operand is read and written, the others are read
+	shapePair                           // the first two operands are written, the others are read
+	shapeSwap                           // the first operand is read, the second is written
+	shapeAccumulatePair                 // the first two operands are read and written, the others are read
+)
+
+// regShapeOf returns how op uses its explicit register operands, shapeDest for
+// the operations that are not listed. The exclusive and LS64 stores are
+// shapeDest as they write a status register.
+func regShapeOf(op Operation) regShape {
+	switch op {
+	case ARM64_AT, ARM64_B, ARM64_BLR, ARM64_BLRAA, ARM64_BLRAAZ, ARM64_BLRAB, ARM64_BLRABZ, ARM64_BR,
+		ARM64_BRAA, ARM64_BRAAZ, ARM64_BRAB, ARM64_BRABZ, ARM64_CBNZ, ARM64_CBZ, ARM64_CCMN, ARM64_CCMP,
+		ARM64_CFP, ARM64_CMN, ARM64_CMP, ARM64_CMPP, ARM64_CPP, ARM64_DC, ARM64_DVP, ARM64_FCCMP,
+		ARM64_FCCMPE, ARM64_FCMP, ARM64_FCMPE, ARM64_IC, ARM64_MSR, ARM64_PRFM, ARM64_PRFUM, ARM64_RET,
+		ARM64_RETAA, ARM64_RETAB, ARM64_ST1, ARM64_ST2, ARM64_ST2G, ARM64_ST3, ARM64_ST4, ARM64_STADDLB,
+		ARM64_STCLRLH, ARM64_STEORL, ARM64_STSETL, ARM64_STSMAXB, ARM64_STSMINH, ARM64_STUMAX,
+		ARM64_STUMIN, ARM64_STSMINL, ARM64_STG, ARM64_STGM, ARM64_STGP, ARM64_STLLRB, ARM64_STLLRH,
+		ARM64_STLLR, ARM64_STLR, ARM64_STLRB, ARM64_STLRH, ARM64_STLUR, ARM64_STLURB, ARM64_STLURH,
+		ARM64_STNP, ARM64_STP, ARM64_STR, ARM64_STRB, ARM64_STRH, ARM64_STTR, ARM64_STTRB, ARM64_STTRH,
+		ARM64_STUR, ARM64_STURB, ARM64_STURH, ARM64_STZ2G, ARM64_STZG, ARM64_STZGM, ARM64_SYS,
+		ARM64_TBNZ, ARM64_TBZ, ARM64_TLBI, ARM64_TST, ARM64_CTERMEQ, ARM64_CTERMNE, ARM64_PTEST,
+		ARM64_WRFFR, ARM64_PRFB, ARM64_PRFD, ARM64_PRFH, ARM64_PRFW, ARM64_ST1B, ARM64_ST1D, ARM64_ST1H,
+		ARM64_ST1W, ARM64_ST2B, ARM64_ST2D, ARM64_ST2H, ARM64_ST2W, ARM64_ST3B, ARM64_ST3D, ARM64_ST3H,
+		ARM64_ST3W, ARM64_ST4B, ARM64_ST4D, ARM64_ST4H, ARM64_ST4W, ARM64_STNT1B, ARM64_STNT1D,
+		ARM64_STNT1H, ARM64_STNT1W, ARM64_ST1Q, ARM64_ST2Q, ARM64_ST3Q, ARM64_ST4Q, ARM64_RMIF,
+		ARM64_SETF16, ARM64_SETF8, ARM64_ST64B, ARM64_WFET, ARM64_WFIT, ARM64_RPRFM, ARM64_GCSSTR,
+		ARM64_GCSSTTR, ARM64_STILP, ARM64_GCSPUSHM, ARM64_GCSSS1, ARM64_MSRR, ARM64_SYSP, ARM64_BRB:
+		return shapeNoDest
+	case ARM64_CASP, ARM64_CASPA, ARM64_CASPAL, ARM64_CASPL, ARM64_LDCLRP, ARM64_LDCLRPA,
+		ARM64_LDCLRPAL, ARM64_LDCLRPL, ARM64_LDSETP, ARM64_LDSETPA, ARM64_LDSETPAL, ARM64_LDSETPL,
+		ARM64_RCWCASP, ARM64_RCWCASPA, ARM64_RCWCASPAL, ARM64_RCWCASPL, ARM64_RCWCLRP, ARM64_RCWCLRPA,
+		ARM64_RCWCLRPAL, ARM64_RCWCLRPL, ARM64_RCWSCASP, ARM64_RCWSCASPA, ARM64_RCWSCASPAL,
+		ARM64_RCWSCASPL, ARM64_RCWSCLRP, ARM64_RCWSCLRPA, ARM64_RCWSCLRPAL, ARM64_RCWSCLRPL,
+		ARM64_RCWSETP, ARM64_RCWSETPA, ARM64_RCWSETPAL, ARM64_RCWSETPL, ARM64_RCWSSETP, ARM64_RCWSSETPA,
+		ARM64_RCWSSETPAL, ARM64_RCWSSETPL, ARM64_RCWSSWPP, ARM64_RCWSSWPPA, ARM64_RCWSSWPPAL,
+		ARM64_RCWSSWPPL, ARM64_RCWSWPP, ARM64_RCWSWPPA, ARM64_RCWSWPPAL, ARM64_RCWSWPPL, ARM64_SWPP,
+		ARM64_SWPPA, ARM64_SWPPAL, ARM64_SWPPL:
+		return shapeAccumulatePair
+	case ARM64_ADDHN2, ARM64_AESD, ARM64_AESE, ARM64_AUTDA, ARM64_AUTDB, ARM64_AUTDZA, ARM64_AUTDZB,
+		ARM64_AUTIA, ARM64_AUTIB, ARM64_AUTIZA, ARM64_AUTIZB, ARM64_BFC, ARM64_BFCVTN2, ARM64_BFDOT,
+		ARM64_BFMLALB, ARM64_BFMLALT, ARM64_BFMMLA, ARM64_BFI, ARM64_BFM, ARM64_BFXIL, ARM64_BIF,
+		ARM64_BIT, ARM64_BSL, ARM64_CASB, ARM64_CASAB, ARM64_CASALB, ARM64_CASLB, ARM64_CASH,
+		ARM64_CASAH, ARM64_CASALH, ARM64_CASLH, ARM64_CAS, ARM64_CASA, ARM64_CASAL, ARM64_CASL,
+		ARM64_FCMLA, ARM64_FCVTN2, ARM64_FCVTXN2, ARM64_FMLA, ARM64_FMLAL, ARM64_FMLSL, ARM64_FMLS,
+		ARM64_INS, ARM64_LDG, ARM64_MLA, ARM64_MLS, ARM64_MOVK, ARM64_PACDA, ARM64_PACDB, ARM64_PACDZA,
+		ARM64_PACDZB, ARM64_PACIA, ARM64_PACIB, ARM64_PACIZA, ARM64_PACIZB, ARM64_RADDHN2, ARM64_RSHRN2,
+		ARM64_RSUBHN2, ARM64_SABA, ARM64_SABAL, ARM64_SABAL2, ARM64_SADALP, ARM64_SDOT, ARM64_SHA1C,
+		ARM64_SHA1M, ARM64_SHA1P, ARM64_SHA1SU0, ARM64_SHA1SU1, ARM64_SHA256H, ARM64_SHA256H2,
+		ARM64_SHA256SU0, ARM64_SHA256SU1, ARM64_SHRN2, ARM64_SLI, ARM64_SMMLA, ARM64_SMLAL, ARM64_SMLAL2,
+		ARM64_SMLSL, ARM64_SMLSL2, ARM64_SQDMLAL, ARM64_SQDMLAL2, ARM64_SQDMLSL, ARM64_SQDMLSL2,
+		ARM64_SQRDMLAH, ARM64_SQRDMLSH, ARM64_SQRSHRN2, ARM64_SQRSHRUN2, ARM64_SQSHRN2, ARM64_SQSHRUN2,
+		ARM64_SQXTN2, ARM64_SQXTUN2, ARM64_SRI, ARM64_SRSRA, ARM64_SSRA, ARM64_SUBHN2, ARM64_SUDOT,
+		ARM64_TBX, ARM64_UABA, ARM64_UABAL, ARM64_UABAL2, ARM64_UADALP, ARM64_UDOT, ARM64_UMLAL,
+		ARM64_UMLAL2, ARM64_UMLSL, ARM64_UMLSL2, ARM64_UMMLA, ARM64_USMMLA, ARM64_UQRSHRN2,
+		ARM64_UQSHRN2, ARM64_UQXTN2, ARM64_URSRA, ARM64_USDOT, ARM64_USRA, ARM64_XPACD, ARM64_XPACI,
+		ARM64_XTN2, ARM64_DECB, ARM64_DECD, ARM64_DECH, ARM64_DECW, ARM64_INCB, ARM64_INCD, ARM64_INCH,
+		ARM64_INCW, ARM64_INSR, ARM64_SQDECB, ARM64_SQDECD, ARM64_SQDECH, ARM64_SQDECW, ARM64_SQINCB,
+		ARM64_SQINCD, ARM64_SQINCH, ARM64_SQINCW, ARM64_UQDECB, ARM64_UQDECD, ARM64_UQDECH, ARM64_UQDECW,
+		ARM64_UQINCB, ARM64_UQINCD, ARM64_UQINCH, ARM64_UQINCW, ARM64_DECP, ARM64_INCP, ARM64_SQDECP,
+		ARM64_SQINCP, ARM64_UQDECP, ARM64_UQINCP, ARM64_ADCLB, ARM64_ADCLT, ARM64_ADDHNT, ARM64_CDOT,
+		ARM64_CMLA, ARM64_RADDHNT, ARM64_RSHRNT, ARM64_RSUBHNT, ARM64_SABALB, ARM64_SABALT, ARM64_SBCLB,
+		ARM64_SBCLT, ARM64_SCLAMP, ARM64_SHRNT, ARM64_SM4E, ARM64_SMLALB, ARM64_SMLALT, ARM64_SMLSLB,
+		ARM64_SMLSLT, ARM64_SQDMLALB, ARM64_SQDMLALBT, ARM64_SQDMLALT, ARM64_SQDMLSLB, ARM64_SQDMLSLBT,
+		ARM64_SQDMLSLT, ARM64_SQRDCMLAH, ARM64_SQRSHRNT, ARM64_SQRSHRUNT, ARM64_SQSHRNT, ARM64_SQSHRUNT,
+		ARM64_SQXTNT, ARM64_SQXTUNT, ARM64_SUBHNT, ARM64_UABALB, ARM64_UABALT, ARM64_UCLAMP,
+		ARM64_UMLALB, ARM64_UMLALT, ARM64_UMLSLB, ARM64_UMLSLT, ARM64_UQRSHRNT, ARM64_UQSHRNT,
+		ARM64_UQXTNT, ARM64_TBXQ, ARM64_BFCVTNT, ARM64_BFMLSLB, ARM64_BFMLSLT, ARM64_FCLAMP,
+		ARM64_FCVTNT, ARM64_FCVTXNT, ARM64_FDOT, ARM64_FMLALB, ARM64_FMLALT, ARM64_FMLSLB, ARM64_FMLSLT,
+		ARM64_FMMLA, ARM64_RCWCAS, ARM64_RCWCASA, ARM64_RCWCASAL, ARM64_RCWCASL, ARM64_RCWSCAS,
+		ARM64_RCWSCASA, ARM64_RCWSCASAL, ARM64_RCWSCASL, ARM64_SHA512H, ARM64_SHA512H2, ARM64_SHA512SU0,
+		ARM64_SHA512SU1, ARM64_SM3PARTW1, ARM64_SM3PARTW2, ARM64_SM3TT1A, ARM64_SM3TT1B, ARM64_SM3TT2A,
+		ARM64_SM3TT2B:
+		return shapeAccumulate
+	case ARM64_LDAXP, ARM64_LDNP, ARM64_LDP, ARM64_LDPSW, ARM64_LDXP, ARM64_LDIAPP, ARM64_MRRS:
+		return shapePair
+	case ARM64_LDADDB, ARM64_LDADDAB, ARM64_LDADDALB, ARM64_LDADDLB, ARM64_LDADDH, ARM64_LDADDAH,
+		ARM64_LDADDALH, ARM64_LDADDLH, ARM64_LDADD, ARM64_LDADDA, ARM64_LDADDAL, ARM64_LDADDL,
+		ARM64_LDCLRB, ARM64_LDCLRAB, ARM64_LDCLRALB, ARM64_LDCLRLB, ARM64_LDCLRH, ARM64_LDCLRAH,
+		ARM64_LDCLRALH, ARM64_LDCLRLH, ARM64_LDCLR, ARM64_LDCLRA, ARM64_LDCLRAL, ARM64_LDCLRL,
+		ARM64_LDEORB, ARM64_LDEORAB, ARM64_LDEORALB, ARM64_LDEORLB, ARM64_LDEORH, ARM64_LDEORAH,
+		ARM64_LDEORALH, ARM64_LDEORLH, ARM64_LDEOR, ARM64_LDEORA, ARM64_LDEORAL, ARM64_LDEORL,
+		ARM64_LDSETB, ARM64_LDSETAB, ARM64_LDSETALB, ARM64_LDSETLB, ARM64_LDSETH, ARM64_LDSETAH,
+		ARM64_LDSETALH, ARM64_LDSETLH, ARM64_LDSET, ARM64_LDSETA, ARM64_LDSETAL, ARM64_LDSETL,
+		ARM64_LDSMAXB, ARM64_LDSMAXAB, ARM64_LDSMAXALB, ARM64_LDSMAXLB, ARM64_LDSMAXH, ARM64_LDSMAXAH,
+		ARM64_LDSMAXALH, ARM64_LDSMAXLH, ARM64_LDSMAX, ARM64_LDSMAXA, ARM64_LDSMAXAL, ARM64_LDSMAXL,
+		ARM64_LDSMINB, ARM64_LDSMINAB, ARM64_LDSMINALB, ARM64_LDSMINLB, ARM64_LDSMINH, ARM64_LDSMINAH,
+		ARM64_LDSMINALH, ARM64_LDSMINLH, ARM64_LDSMIN, ARM64_LDSMINA, ARM64_LDSMINAL, ARM64_LDSMINL,
+		ARM64_LDUMAXB, ARM64_LDUMAXAB, ARM64_LDUMAXALB, ARM64_LDUMAXLB, ARM64_LDUMAXH, ARM64_LDUMAXAH,
+		ARM64_LDUMAXALH, ARM64_LDUMAXLH, ARM64_LDUMAX, ARM64_LDUMAXA, ARM64_LDUMAXAL, ARM64_LDUMAXL,
+		ARM64_LDUMINB, ARM64_LDUMINAB, ARM64_LDUMINALB, ARM64_LDUMINLB, ARM64_LDUMINH, ARM64_LDUMINAH,
+		ARM64_LDUMINALH, ARM64_LDUMINLH, ARM64_LDUMIN, ARM64_LDUMINA, ARM64_LDUMINAL, ARM64_LDUMINL,
+		ARM64_SWP, ARM64_SWPA, ARM64_SWPAB, ARM64_SWPAH, ARM64_SWPALB, ARM64_SWPALH, ARM64_SWPB,
+		ARM64_SWPH, ARM64_SWPL, ARM64_SWPLB, ARM64_SWPLH, ARM64_SWPAL, ARM64_RCWCLR, ARM64_RCWCLRA,
+		ARM64_RCWCLRAL, ARM64_RCWCLRL, ARM64_RCWSCLR, ARM64_RCWSCLRA, ARM64_RCWSCLRAL, ARM64_RCWSCLRL,
+		ARM64_RCWSET, ARM64_RCWSETA, ARM64_RCWSETAL, ARM64_RCWSETL, ARM64_RCWSSET, ARM64_RCWSSETA,
+		ARM64_RCWSSETAL, ARM64_RCWSSETL, ARM64_RCWSSWP, ARM64_RCWSSWPA, ARM64_RCWSSWPAL, ARM64_RCWSSWPL,
+		ARM64_RCWSWP, ARM64_RCWSWPA, ARM64_RCWSWPAL, ARM64_RCWSWPL:
+		return shapeSwap
+	}
+	return shapeDest
+}
+
+// regSet collects canonical registers in the order they are first added
+type regSet []Register
+
+func (s *regSet) add(regs ...Register) {
+	for _, r := range regs {
+		r = r.Canonical()
+		if !r.isStorage() {
+			continue
+		}
+		found := false
+		for _, have := range *s {
+			if have == r {
+				found = true
+				break
+			}
+		}
+		if !found {
+			*s = append(*s, r)
+		}
+	}
+}
+
+// gprs returns the n consecutive X registers starting at r
+func gprs(r Register, n int) []Register {
+	var regs []Register
+	r = r.Canonical()
+	for k := 0; k < n && r+Register(k) <= REG_X30; k++ {
+		regs = append(regs, r+Register(k))
+	}
+	return regs
+}
+
+// RegsRead returns the registers i reads, explicitly or implicitly, as their
+// Canonical full registers, e.g. x30 for RET, x16 and x17 for PACIA1716, the
+// base of a memory operand and REG_FLAGS for the condition flags. A register
+// that is only partially written, e.g. the vector of INS or the destination of
+// an accumulating instruction, is also read.
+func (i *Instruction) RegsRead() []Register {
+	read, _ := i.regs()
+	return read
+}
+
+// RegsWritten returns the registers i writes, explicitly or implicitly, as
+// their Canonical full registers, e.g. x30 for BL, the base of a pre or post
+// indexed memory operand and REG_FLAGS for the condition flags. Writes to the
+// zero registers are not reported.
+func (i *Instruction) RegsWritten() []Register {
+	_, written := i.regs()
+	return written
+}
+
+func (i *Instruction) regs() (regSet, regSet) {
+	var read, written regSet
+	if i.operation == ARM64_UNDEFINED {
+		return nil, nil
+	}
+	shape := regShapeOf(i.operation)
+	explicit := 0
+	for idx, op := range i.operands {
+		if op.IndexReg != uint32(REG_NONE) {
+			read.add(Register(op.IndexReg))
+		}
+		switch op.OpClass {
+		case REG, MULTI_REG, SME_TILE:
+			var regs []Register
+			for _, r := range op.Reg {
+				if Register(r) != REG_NONE {
+					regs = append(regs, Register(r))
+				}
+			}
+			explicit++
+			isRead, isWritten := shapeRoles(shape, idx)
+			if idx == 0 && isWritten && i.partialWrite(op) {
+				isRead = true
+			}
+			if op.Writeback {
+				isRead, isWritten = true, true
+			}
+			if isRead {
+				read.add(regs...)
+			}
+			if isWritten {
+				written.add(regs...)
+			}
+		case SME_TILE_LIST:
+			if op.Immediate&0xff != 0 {
+				read.add(REG_ZA)
+				written.add(REG_ZA)
+			}
+		case MEM_REG, MEM_OFFSET, MEM_EXTENDED, MEM_PRE_IDX, MEM_POST_IDX:
+			read.add(Register(op.Reg[0]), Register(op.Reg[1]))
+			if op.OpClass == MEM_PRE_IDX || op.OpClass == MEM_POST_IDX || op.Writeback {
+				written.add(Register(op.Reg[0]))
+			}
+		}
+	}
+	i.implicitRegs(explicit, &read, &written)
+	return read, written
+}
+
+// shapeRoles returns whether the explicit register operand idx of an operation
+// of the given shape is read and written
+func shapeRoles(shape regShape, idx int) (isRead, isWritten bool) {
+	switch shape {
+	case shapeNoDest:
+		return true, false
+	case shapeAccumulate:
+		return true, idx == 0
+	case shapePair:
+		return idx > 1, idx <= 1
+	case shapeSwap:
+		return idx != 1, idx == 1
+	case shapeAccumulatePair:
+		return true, idx <= 1
+	}
+	return idx != 0, idx == 0
+}
+
+// partialWrite reports whether writing the destination op of i preserves part
+// of its register, which makes it a read as well
+func (i *Instruction) partialWrite(op InstructionOperand) bool {
+	switch {
+	case op.OpClass == SME_TILE:
+		return true // a ZA tile, slice or array vector
+	case op.OpClass == REG && Register(op.Reg[0]) >= REG_ZA && Register(op.Reg[0]) <= REG_ZA15:
+		return true
+	case op.OpClass == REG && (op.HasScale || op.Scale > 0) && Register(op.Reg[0]) >= REG_V0 && Register(op.Reg[0]) <= REG_Q31:
+		return true // a vector element, e.g. INS or FMOV v0.d[1], x0
+	case op.OpClass == MULTI_REG && i.raw&0xbf000000 == 0x0d000000:
+		// Advanced SIMD load single structure, other than the replicating forms
+		switch i.operation {
+		case ARM64_LD1, ARM64_LD2, ARM64_LD3, ARM64_LD4:
+			return true
+		}
+	}
+	for _, other := range i.operands {
+		if other.PredQual == PRED_MERGE {
+			return true // the inactive elements are kept
+		}
+	}
+	return false
+}
+
+// implicitRegs adds the registers i accesses without an operand, explicit is
+// the number of register operands of i
+func (i *Instruction) implicitRegs(explicit int, read, written *regSet) {
+	switch i.operation {
+	case ARM64_BL, ARM64_BLR, ARM64_BLRAA, ARM64_BLRAAZ, ARM64_BLRAB, ARM64_BLRABZ:
+		written.add(REG_X30)
+	case ARM64_RET:
+		if explicit == 0 {
+			read.add(REG_X30)
+		}
+	case ARM64_RETAA, ARM64_RETAB:
+		read.add(REG_X30, REG_SP)
+	case ARM64_ERETAA, ARM64_ERETAB:
+		read.add(REG_SP)
+	case ARM64_PACIA1716, ARM64_PACIB1716, ARM64_AUTIA1716, ARM64_AUTIB1716:
+		read.add(REG_X16, REG_X17)
+		written.add(REG_X17)
+	case ARM64_PACIASP, ARM64_PACIBSP, ARM64_AUTIASP, ARM64_AUTIBSP:
+		read.add(REG_X30, REG_SP)
+		written.add(REG_X30)
+	case ARM64_PACIAZ, ARM64_PACIBZ, ARM64_AUTIAZ, ARM64_AUTIBZ, ARM64_XPACLRI:
+		read.add(REG_X30)
+		written.add(REG_X30)
+	case ARM64_LD64B:
+		written.add(gprs(Register(i.operands[0].Reg[0]), 8)...)
+	case ARM64_ST64B:
+		read.add(gprs(Register(i.operands[0].Reg[0]), 8)...)
+	case ARM64_ST64BV, ARM64_ST64BV0:
+		read.add(gprs(Register(i.operands[1].Reg[0]), 8)...)
+	case ARM64_RDFFR, ARM64_RDFFRS:
+		read.add(REG_FFR)
+	case ARM64_SETFFR, ARM64_WRFFR:
+		written.add(REG_FFR)
+	case ARM64_LDFF1B, ARM64_LDFF1D, ARM64_LDFF1H, ARM64_LDFF1SB, ARM64_LDFF1SH, ARM64_LDFF1SW, ARM64_LDFF1W,
+		ARM64_LDNF1B, ARM64_LDNF1D, ARM64_LDNF1H, ARM64_LDNF1SB, ARM64_LDNF1SH, ARM64_LDNF1SW, ARM64_LDNF1W:
+		read.add(REG_FFR) // faulting elements clear their FFR bits
+		written.add(REG_FFR)
+	}
+	// writing only some of the flags keeps the others
+	if flags := i.FlagsWritten(); flags != FLAGS_NONE {
+		if flags != FLAGS_NZCV {
+			read.add(REG_FLAGS)
+		}
+		written.add(REG_FLAGS)
+	}
+	if i.FlagsRead() != FLAGS_NONE {
+		read.add(REG_FLAGS)
+	}
+}
@@ -0,0 +1,155 @@
+package arm64
+
+import (
+	"fmt"
+	"sync"
+)
+
+//---------------------------------------------
+// Implementation defined system register names
+//---------------------------------------------
+
+// vendorSysRegs maps the op0:op1:CRn:CRm:op2 encoding of an implementation
+// defined system register to its name, per vendor
+var vendorSysRegs = struct {
+	sync.RWMutex
+	names map[Vendor]map[uint32]string
+}{
+	names: map[Vendor]map[uint32]string{
+		VENDOR_APPLE: appleSysRegs(),
+	},
+}
+
+func sysRegEncoding(op0, op1, crn, crm, op2 uint32) uint32 {
+	return (op0&3)<<14 | (op1&7)<<11 | (crn&15)<<7 | (crm&15)<<3 | op2&7
+}
+
+// RegisterSystemRegister names the implementation defined system register
+// S<op0>_<op1>_C<crn>_C<crm>_<op2> for instructions decoded with vendor,
+// replacing any existing name for that encoding
+func RegisterSystemRegister(vendor Vendor, name string, op0, op1, crn, crm, op2 uint32) error {
+	if op0 > 3 || op1 > 7 || crn > 15 || crm > 15 || op2 > 7 {
+		return fmt.Errorf("invalid system register encoding s%d_%d_c%d_c%d_%d", op0, op1, crn, crm, op2)
+	}
+	vendorSysRegs.Lock()
+	defer vendorSysRegs.Unlock()
+	if vendorSysRegs.names[vendor] == nil {
+		vendorSysRegs.names[vendor] = make(map[uint32]string)
+	}
+	vendorSysRegs.names[vendor][sysRegEncoding(op0, op1, crn, crm, op2)] = name
+	return nil
+}
+
+// LookupSystemRegister returns the name registered for the implementation
+// defined system register S<op0>_<op1>_C<crn>_C<crm>_<op2> of vendor
+func LookupSystemRegister(vendor Vendor, op0, op1, crn, crm, op2 uint32) (string, bool) {
+	vendorSysRegs.RLock()
+	defer vendorSysRegs.RUnlock()
+	name, ok := vendorSysRegs.names[vendor][sysRegEncoding(op0, op1, crn, crm, op2)]
+	return name, ok
+}
+
+// appleSysRegs returns the Apple silicon system registers documented by the
+// Asahi Linux and m1n1 projects and the XNU sources
+func appleSysRegs() map[uint32]string {
+	var sysregs = []struct {
+		op0, op1, crn, crm, op2 uint32
+		name                    string
+	}{
+		// Hardware implementation defined (chicken bit) registers
+		{3, 0, 15, 0, 0, "hid0"},
+		{3, 0, 15, 0, 1, "ehid0"},
+		{3, 0, 15, 1, 0, "hid1"},
+		{3, 0, 15, 1, 1, "ehid1"},
+		{3, 0, 15, 1, 2, "ehid20"},
+		{3, 0, 15, 1, 3, "hid21"},
+		{3, 0, 15, 2, 0, "hid2"},
+		{3, 0, 15, 2, 1, "ehid2"},
+		{3, 0, 15, 3, 0, "hid3"},
+		{3, 0, 15, 3, 1, "ehid3"},
+		{3, 0, 15, 4, 0, "hid4"},
+		{3, 0, 15, 4, 1, "ehid4"},
+		{3, 0, 15, 5, 0, "hid5"},
+		{3, 0, 15, 5, 1, "ehid5"},
+		{3, 0, 15, 6, 0, "hid6"},
+		{3, 0, 15, 7, 0, "hid7"},
+		{3, 0, 15, 7, 1, "ehid7"},
+		{3, 0, 15, 8, 0, "hid8"},
+		{3, 0, 15, 9, 0, "hid9"},
+		{3, 0, 15, 9, 1, "ehid9"},
+		{3, 0, 15, 10, 0, "hid10"},
+		{3, 0, 15, 10, 1, "ehid10"},
+		{3, 0, 15, 11, 0, "hid11"},
+		{3, 0, 15, 11, 1, "ehid11"},
+		{3, 0, 15, 11, 2, "hid18"},
+		{3, 0, 15, 14, 0, "hid13"},
+		{3, 0, 15, 15, 0, "hid14"},
+		{3, 0, 15, 15, 2, "hid16"},
+		{3, 0, 15, 15, 5, "hid17"},
+		// Performance monitors
+		{3, 1, 15, 0, 0, "pmcr0"},
+		{3, 1, 15, 1, 0, "pmcr1"},
+		{3, 1, 15, 2, 0, "pmcr2"},
+		{3, 1, 15, 3, 0, "pmcr3"},
+		{3, 1, 15, 4, 0, "pmcr4"},
+		{3, 1, 15, 5, 0, "pmesr0"},
+		{3, 1, 15, 6, 0, "pmesr1"},
+		{3, 1, 15, 13, 0, "pmsr"},
+		{3, 2, 15, 0, 0, "pmc0"},
+		{3, 2, 15, 1, 0, "pmc1"},
+		{3, 2, 15, 2, 0, "pmc2"},
+		{3, 2, 15, 3, 0, "pmc3"},
+		{3, 2, 15, 4, 0, "pmc4"},
+		{3, 2, 15, 5, 0, "pmc5"},
+		{3, 2, 15, 6, 0, "pmc6"},
+		{3, 2, 15, 7, 0, "pmc7"},
+		{3, 2, 15, 9, 0, "pmc8"},
+		{3, 2, 15, 10, 0, "pmc9"},
+		// Error reporting
+		{3, 3, 15, 0, 0, "lsu_err_sts"},
+		{3, 3, 15, 2, 0, "e_lsu_err_sts"},
+		{3, 3, 15, 8, 0, "l2c_err_sts"},
+		{3, 3, 15, 9, 0, "l2c_err_adr"},
+		{3, 3, 15, 10, 0, "l2c_err_inf"},
+		{3, 4, 15, 0, 0, "fed_err_sts"},
+		{3, 4, 15, 0, 2, "e_fed_err_sts"},
+		{3, 6, 15, 0, 0, "mmu_err_sts"},
+		{3, 6, 15, 2, 0, "e_mmu_err_sts"},
+		// Pointer authentication and kernel text protection
+		{3, 4, 15, 0, 4, "apctl_el1"},
+		{3, 4, 15, 1, 0, "kernkeylo_el1"},
+		{3, 4, 15, 1, 1, "kernkeyhi_el1"},
+		{3, 6, 15, 12, 4, "apsts_el1"},
+		{3, 4, 15, 2, 0, "aprr_el0"},
+		{3, 4, 15, 2, 1, "aprr_el1"},
+		{3, 4, 15, 2, 2, "ktrr_lock_el1"},
+		{3, 4, 15, 2, 3, "ktrr_lower_el1"},
+		{3, 4, 15, 2, 4, "ktrr_upper_el1"},
+		// AMX coprocessor control
+		{3, 4, 15, 1, 4, "amx_ctl_el1"},
+		{3, 4, 15, 4, 6, "amx_ctl_el12"},
+		{3, 4, 15, 4, 7, "amx_ctl_el2"},
+		// Fast IPIs and cluster power
+		{3, 5, 15, 0, 0, "ipi_rr_local_el1"},
+		{3, 5, 15, 0, 1, "ipi_rr_global_el1"},
+		{3, 5, 15, 1, 1, "ipi_sr_el1"},
+		{3, 5, 15, 1, 3, "vm_tmr_fiq_ena_el2"},
+		{3, 5, 15, 3, 1, "ipi_cr_el1"},
+		{3, 5, 15, 4, 0, "acc_cfg"},
+		{3, 5, 15, 5, 0, "cyc_ovrd"},
+		// Shadow permission remapping (SPRR) and guarded execution (GXF)
+		{3, 6, 15, 1, 0, "sprr_config_el1"},
+		{3, 6, 15, 1, 2, "gxf_config_el1"},
+		{3, 6, 15, 1, 5, "sprr_perm_el0"},
+		{3, 6, 15, 1, 6, "sprr_perm_el1"},
+		{3, 6, 15, 8, 0, "gxf_status_el1"},
+		{3, 6, 15, 8, 1, "gxf_enter_el1"},
+		{3, 6, 15, 8, 2, "gxf_abort_el1"},
+		{3, 6, 15, 14, 6, "actlr_el12"},
+	}
+	names := make(map[uint32]string, len(sysregs))
+	for _, r := range sysregs {
+		names[sysRegEncoding(r.op0, r.op1, r.crn, r.crm, r.op2)] = r.name
+	}
+	return names
+}
@@ -9,7 +9,7 @@ import (
 	"math/bits"
 )
 
-const MAX_OPERANDS = 5
+const MAX_OPERANDS = 6
 
 type Operation uint32
 
@@ -744,6 +744,713 @@ const (
 
 	ARM64_BTI
 
+	ARM64_ADDPL   // SVE
+	ARM64_ADDVL   // SVE
+	ARM64_ANDV    // SVE
+	ARM64_ASRD    // SVE
+	ARM64_ASRR    // SVE
+	ARM64_CLASTA  // SVE
+	ARM64_CLASTB  // SVE
+	ARM64_CNOT    // SVE
+	ARM64_CNTB    // SVE
+	ARM64_CNTD    // SVE
+	ARM64_CNTH    // SVE
+	ARM64_CNTW    // SVE
+	ARM64_COMPACT // SVE
+	ARM64_DECB    // SVE
+	ARM64_DECD    // SVE
+	ARM64_DECH    // SVE
+	ARM64_DECW    // SVE
+	ARM64_DUPM    // SVE
+	ARM64_EORV    // SVE
+	ARM64_FEXPA   // SVE
+	ARM64_FTSSEL  // SVE
+	ARM64_INCB    // SVE
+	ARM64_INCD    // SVE
+	ARM64_INCH    // SVE
+	ARM64_INCW    // SVE
+	ARM64_INDEX   // SVE
+	ARM64_INSR    // SVE
+	ARM64_LASTA   // SVE
+	ARM64_LASTB   // SVE
+	ARM64_LSLR    // SVE
+	ARM64_LSRR    // SVE
+	ARM64_MAD     // SVE
+	ARM64_MOVPRFX // SVE
+	ARM64_MSB     // SVE
+	ARM64_ORV     // SVE
+	ARM64_PUNPKHI // SVE
+	ARM64_PUNPKLO // SVE
+	ARM64_RDVL    // SVE
+	ARM64_REVB    // SVE
+	ARM64_REVH    // SVE
+	ARM64_REVW    // SVE
+	ARM64_SADDV   // SVE
+	ARM64_SDIVR   // SVE
+	ARM64_SEL     // SVE
+	ARM64_SPLICE  // SVE
+	ARM64_SQDECB  // SVE
+	ARM64_SQDECD  // SVE
+	ARM64_SQDECH  // SVE
+	ARM64_SQDECW  // SVE
+	ARM64_SQINCB  // SVE
+	ARM64_SQINCD  // SVE
+	ARM64_SQINCH  // SVE
+	ARM64_SQINCW  // SVE
+	ARM64_SUBR    // SVE
+	ARM64_SUNPKHI // SVE
+	ARM64_SUNPKLO // SVE
+	ARM64_UADDV   // SVE
+	ARM64_UDIVR   // SVE
+	ARM64_UQDECB  // SVE
+	ARM64_UQDECD  // SVE
+	ARM64_UQDECH  // SVE
+	ARM64_UQDECW  // SVE
+	ARM64_UQINCB  // SVE
+	ARM64_UQINCD  // SVE
+	ARM64_UQINCH  // SVE
+	ARM64_UQINCW  // SVE
+	ARM64_UUNPKHI // SVE
+	ARM64_UUNPKLO // SVE
+	ARM64_UXTW    // SVE
+
+	ARM64_BRKA    // SVE
+	ARM64_BRKAS   // SVE
+	ARM64_BRKB    // SVE
+	ARM64_BRKBS   // SVE
+	ARM64_BRKN    // SVE
+	ARM64_BRKNS   // SVE
+	ARM64_BRKPA   // SVE
+	ARM64_BRKPAS  // SVE
+	ARM64_BRKPB   // SVE
+	ARM64_BRKPBS  // SVE
+	ARM64_CMPEQ   // SVE
+	ARM64_CMPGE   // SVE
+	ARM64_CMPGT   // SVE
+	ARM64_CMPHI   // SVE
+	ARM64_CMPHS   // SVE
+	ARM64_CMPLE   // SVE
+	ARM64_CMPLO   // SVE
+	ARM64_CMPLS   // SVE
+	ARM64_CMPLT   // SVE
+	ARM64_CMPNE   // SVE
+	ARM64_CNTP    // SVE
+	ARM64_CTERMEQ // SVE
+	ARM64_CTERMNE // SVE
+	ARM64_DECP    // SVE
+	ARM64_EORS    // SVE
+	ARM64_INCP    // SVE
+	ARM64_MOVS    // SVE
+	ARM64_NAND    // SVE
+	ARM64_NANDS   // SVE
+	ARM64_NOR     // SVE
+	ARM64_NORS    // SVE
+	ARM64_NOTS    // SVE
+	ARM64_ORNS    // SVE
+	ARM64_ORRS    // SVE
+	ARM64_PFALSE  // SVE
+	ARM64_PFIRST  // SVE
+	ARM64_PNEXT   // SVE
+	ARM64_PTEST   // SVE
+	ARM64_PTRUE   // SVE
+	ARM64_PTRUES  // SVE
+	ARM64_RDFFR   // SVE
+	ARM64_RDFFRS  // SVE
+	ARM64_SETFFR  // SVE
+	ARM64_SQDECP  // SVE
+	ARM64_SQINCP  // SVE
+	ARM64_UQDECP  // SVE
+	ARM64_UQINCP  // SVE
+	ARM64_WHILELE // SVE
+	ARM64_WHILELO // SVE
+	ARM64_WHILELS // SVE
+	ARM64_WHILELT // SVE
+	ARM64_WRFFR   // SVE
+
+	ARM64_FADDA  // SVE
+	ARM64_FADDV  // SVE
+	ARM64_FCMNE  // SVE
+	ARM64_FCMUO  // SVE
+	ARM64_FDIVR  // SVE
+	ARM64_FMAD   // SVE
+	ARM64_FMSB   // SVE
+	ARM64_FNMAD  // SVE
+	ARM64_FNMLA  // SVE
+	ARM64_FNMLS  // SVE
+	ARM64_FNMSB  // SVE
+	ARM64_FSCALE // SVE
+	ARM64_FSUBR  // SVE
+	ARM64_FTMAD  // SVE
+	ARM64_FTSMUL // SVE
+
+	ARM64_LD1B    // SVE
+	ARM64_LD1D    // SVE
+	ARM64_LD1H    // SVE
+	ARM64_LD1RB   // SVE
+	ARM64_LD1RD   // SVE
+	ARM64_LD1RH   // SVE
+	ARM64_LD1ROB  // SVE
+	ARM64_LD1ROD  // SVE
+	ARM64_LD1ROH  // SVE
+	ARM64_LD1ROW  // SVE
+	ARM64_LD1RQB  // SVE
+	ARM64_LD1RQD  // SVE
+	ARM64_LD1RQH  // SVE
+	ARM64_LD1RQW  // SVE
+	ARM64_LD1RSB  // SVE
+	ARM64_LD1RSH  // SVE
+	ARM64_LD1RSW  // SVE
+	ARM64_LD1RW   // SVE
+	ARM64_LD1SB   // SVE
+	ARM64_LD1SH   // SVE
+	ARM64_LD1SW   // SVE
+	ARM64_LD1W    // SVE
+	ARM64_LD2B    // SVE
+	ARM64_LD2D    // SVE
+	ARM64_LD2H    // SVE
+	ARM64_LD2W    // SVE
+	ARM64_LD3B    // SVE
+	ARM64_LD3D    // SVE
+	ARM64_LD3H    // SVE
+	ARM64_LD3W    // SVE
+	ARM64_LD4B    // SVE
+	ARM64_LD4D    // SVE
+	ARM64_LD4H    // SVE
+	ARM64_LD4W    // SVE
+	ARM64_LDFF1B  // SVE
+	ARM64_LDFF1D  // SVE
+	ARM64_LDFF1H  // SVE
+	ARM64_LDFF1SB // SVE
+	ARM64_LDFF1SH // SVE
+	ARM64_LDFF1SW // SVE
+	ARM64_LDFF1W  // SVE
+	ARM64_LDNF1B  // SVE
+	ARM64_LDNF1D  // SVE
+	ARM64_LDNF1H  // SVE
+	ARM64_LDNF1SB // SVE
+	ARM64_LDNF1SH // SVE
+	ARM64_LDNF1SW // SVE
+	ARM64_LDNF1W  // SVE
+	ARM64_LDNT1B  // SVE
+	ARM64_LDNT1D  // SVE
+	ARM64_LDNT1H  // SVE
+	ARM64_LDNT1W  // SVE
+	ARM64_PRFB    // SVE
+	ARM64_PRFD    // SVE
+	ARM64_PRFH    // SVE
+	ARM64_PRFW    // SVE
+	ARM64_ST1B    // SVE
+	ARM64_ST1D    // SVE
+	ARM64_ST1H    // SVE
+	ARM64_ST1W    // SVE
+	ARM64_ST2B    // SVE
+	ARM64_ST2D    // SVE
+	ARM64_ST2H    // SVE
+	ARM64_ST2W    // SVE
+	ARM64_ST3B    // SVE
+	ARM64_ST3D    // SVE
+	ARM64_ST3H    // SVE
+	ARM64_ST3W    // SVE
+	ARM64_ST4B    // SVE
+	ARM64_ST4D    // SVE
+	ARM64_ST4H    // SVE
+	ARM64_ST4W    // SVE
+	ARM64_STNT1B  // SVE
+	ARM64_STNT1D  // SVE
+	ARM64_STNT1H  // SVE
+	ARM64_STNT1W  // SVE
+
+	ARM64_ADCLB     // SVE2
+	ARM64_ADCLT     // SVE2
+	ARM64_ADDHNB    // SVE2
+	ARM64_ADDHNT    // SVE2
+	ARM64_BDEP      // SVE2
+	ARM64_BEXT      // SVE2
+	ARM64_BGRP      // SVE2
+	ARM64_CADD      // SVE2
+	ARM64_CDOT      // SVE2
+	ARM64_CMLA      // SVE2
+	ARM64_EORBT     // SVE2
+	ARM64_EORTB     // SVE2
+	ARM64_HISTCNT   // SVE2
+	ARM64_HISTSEG   // SVE2
+	ARM64_MATCH     // SVE2
+	ARM64_NMATCH    // SVE2
+	ARM64_PMULLB    // SVE2
+	ARM64_PMULLT    // SVE2
+	ARM64_RADDHNB   // SVE2
+	ARM64_RADDHNT   // SVE2
+	ARM64_RAX1      // SVE2
+	ARM64_RSHRNB    // SVE2
+	ARM64_RSHRNT    // SVE2
+	ARM64_RSUBHNB   // SVE2
+	ARM64_RSUBHNT   // SVE2
+	ARM64_SABALB    // SVE2
+	ARM64_SABALT    // SVE2
+	ARM64_SABDLB    // SVE2
+	ARM64_SABDLT    // SVE2
+	ARM64_SADDLB    // SVE2
+	ARM64_SADDLBT   // SVE2
+	ARM64_SADDLT    // SVE2
+	ARM64_SADDWB    // SVE2
+	ARM64_SADDWT    // SVE2
+	ARM64_SBCLB     // SVE2
+	ARM64_SBCLT     // SVE2
+	ARM64_SCLAMP    // SVE2
+	ARM64_SHRNB     // SVE2
+	ARM64_SHRNT     // SVE2
+	ARM64_SHSUBR    // SVE2
+	ARM64_SM4E      // SVE2
+	ARM64_SM4EKEY   // SVE2
+	ARM64_SMLALB    // SVE2
+	ARM64_SMLALT    // SVE2
+	ARM64_SMLSLB    // SVE2
+	ARM64_SMLSLT    // SVE2
+	ARM64_SMULLB    // SVE2
+	ARM64_SMULLT    // SVE2
+	ARM64_SQCADD    // SVE2
+	ARM64_SQCVTN    // SVE2
+	ARM64_SQCVTUN   // SVE2
+	ARM64_SQDMLALB  // SVE2
+	ARM64_SQDMLALBT // SVE2
+	ARM64_SQDMLALT  // SVE2
+	ARM64_SQDMLSLB  // SVE2
+	ARM64_SQDMLSLBT // SVE2
+	ARM64_SQDMLSLT  // SVE2
+	ARM64_SQDMULLB  // SVE2
+	ARM64_SQDMULLT  // SVE2
+	ARM64_SQRDCMLAH // SVE2
+	ARM64_SQRSHLR   // SVE2
+	ARM64_SQRSHRNB  // SVE2
+	ARM64_SQRSHRNT  // SVE2
+	ARM64_SQRSHRUNB // SVE2
+	ARM64_SQRSHRUNT // SVE2
+	ARM64_SQSHLR    // SVE2
+	ARM64_SQSHRNB   // SVE2
+	ARM64_SQSHRNT   // SVE2
+	ARM64_SQSHRUNB  // SVE2
+	ARM64_SQSHRUNT  // SVE2
+	ARM64_SQSUBR    // SVE2
+	ARM64_SQXTNB    // SVE2
+	ARM64_SQXTNT    // SVE2
+	ARM64_SQXTUNB   // SVE2
+	ARM64_SQXTUNT   // SVE2
+	ARM64_SRSHLR    // SVE2
+	ARM64_SSHLLB    // SVE2
+	ARM64_SSHLLT    // SVE2
+	ARM64_SSUBLB    // SVE2
+	ARM64_SSUBLBT   // SVE2
+	ARM64_SSUBLT    // SVE2
+	ARM64_SSUBLTB   // SVE2
+	ARM64_SSUBWB    // SVE2
+	ARM64_SSUBWT    // SVE2
+	ARM64_SUBHNB    // SVE2
+	ARM64_SUBHNT    // SVE2
+	ARM64_TBLQ      // SVE2
+	ARM64_UABALB    // SVE2
+	ARM64_UABALT    // SVE2
+	ARM64_UABDLB    // SVE2
+	ARM64_UABDLT    // SVE2
+	ARM64_UADDLB    // SVE2
+	ARM64_UADDLT    // SVE2
+	ARM64_UADDWB    // SVE2
+	ARM64_UADDWT    // SVE2
+	ARM64_UCLAMP    // SVE2
+	ARM64_UHSUBR    // SVE2
+	ARM64_UMLALB    // SVE2
+	ARM64_UMLALT    // SVE2
+	ARM64_UMLSLB    // SVE2
+	ARM64_UMLSLT    // SVE2
+	ARM64_UMULLB    // SVE2
+	ARM64_UMULLT    // SVE2
+	ARM64_UQCVTN    // SVE2
+	ARM64_UQRSHLR   // SVE2
+	ARM64_UQRSHRNB  // SVE2
+	ARM64_UQRSHRNT  // SVE2
+	ARM64_UQSHLR    // SVE2
+	ARM64_UQSHRNB   // SVE2
+	ARM64_UQSHRNT   // SVE2
+	ARM64_UQSUBR    // SVE2
+	ARM64_UQXTNB    // SVE2
+	ARM64_UQXTNT    // SVE2
+	ARM64_URSHLR    // SVE2
+	ARM64_USHLLB    // SVE2
+	ARM64_USHLLT    // SVE2
+	ARM64_USUBLB    // SVE2
+	ARM64_USUBLT    // SVE2
+	ARM64_USUBWB    // SVE2
+	ARM64_USUBWT    // SVE2
+	ARM64_UZPQ1     // SVE2
+	ARM64_UZPQ2     // SVE2
+	ARM64_ZIPQ1     // SVE2
+	ARM64_ZIPQ2     // SVE2
+
+	ARM64_ADDQV  // SVE2
+	ARM64_ANDQV  // SVE2
+	ARM64_DUPQ   // SVE2
+	ARM64_EORQV  // SVE2
+	ARM64_EXTQ   // SVE2
+	ARM64_ORQV   // SVE2
+	ARM64_PMOV   // SVE2
+	ARM64_REVD   // SVE2
+	ARM64_SMAXQV // SVE2
+	ARM64_SMINQV // SVE2
+	ARM64_TBXQ   // SVE2
+	ARM64_UMAXQV // SVE2
+	ARM64_UMINQV // SVE2
+
+	ARM64_BCAX  // SVE2
+	ARM64_BSL1N // SVE2
+	ARM64_BSL2N // SVE2
+	ARM64_EOR3  // SVE2
+	ARM64_NBSL  // SVE2
+	ARM64_XAR   // SVE2
+
+	ARM64_PEXT    // SVE2
+	ARM64_PSEL    // SVE2
+	ARM64_WHILEGE // SVE2
+	ARM64_WHILEGT // SVE2
+	ARM64_WHILEHI // SVE2
+	ARM64_WHILEHS // SVE2
+	ARM64_WHILERW // SVE2
+	ARM64_WHILEWR // SVE2
+
+	ARM64_BFCVTNT  // SVE2
+	ARM64_BFMLSLB  // SVE2
+	ARM64_BFMLSLT  // SVE2
+	ARM64_FADDQV   // SVE2
+	ARM64_FCLAMP   // SVE2
+	ARM64_FCVTLT   // SVE2
+	ARM64_FCVTNT   // SVE2
+	ARM64_FCVTXNT  // SVE2
+	ARM64_FDOT     // SVE2
+	ARM64_FMAXNMQV // SVE2
+	ARM64_FMAXQV   // SVE2
+	ARM64_FMINNMQV // SVE2
+	ARM64_FMINQV   // SVE2
+	ARM64_FMLALB   // SVE2
+	ARM64_FMLALT   // SVE2
+	ARM64_FMLSLB   // SVE2
+	ARM64_FMLSLT   // SVE2
+	ARM64_FMMLA    // SVE2
+
+	ARM64_FCVTX // SVE2
+	ARM64_FLOGB // SVE2
+
+	ARM64_LDNT1SB // SVE2
+	ARM64_LDNT1SH // SVE2
+	ARM64_LDNT1SW // SVE2
+
+	ARM64_LD1Q // SVE2
+	ARM64_LD2Q // SVE2
+	ARM64_LD3Q // SVE2
+	ARM64_LD4Q // SVE2
+	ARM64_ST1Q // SVE2
+	ARM64_ST2Q // SVE2
+	ARM64_ST3Q // SVE2
+	ARM64_ST4Q // SVE2
+
+	ARM64_ADDHA   // SME
+	ARM64_ADDSPL  // SME
+	ARM64_ADDSVL  // SME
+	ARM64_ADDVA   // SME
+	ARM64_BFMOPA  // SME
+	ARM64_BFMOPS  // SME
+	ARM64_FMOPA   // SME
+	ARM64_FMOPS   // SME
+	ARM64_RDSVL   // SME
+	ARM64_SMOPA   // SME
+	ARM64_SMOPS   // SME
+	ARM64_SMSTART // SME
+	ARM64_SMSTOP  // SME
+	ARM64_SUMOPA  // SME
+	ARM64_SUMOPS  // SME
+	ARM64_UMOPA   // SME
+	ARM64_UMOPS   // SME
+	ARM64_USMOPA  // SME
+	ARM64_USMOPS  // SME
+	ARM64_ZERO    // SME
+
+	ARM64_CPYE      // MOPS
+	ARM64_CPYEN     // MOPS
+	ARM64_CPYERN    // MOPS
+	ARM64_CPYERT    // MOPS
+	ARM64_CPYERTN   // MOPS
+	ARM64_CPYERTRN  // MOPS
+	ARM64_CPYERTWN  // MOPS
+	ARM64_CPYET     // MOPS
+	ARM64_CPYETN    // MOPS
+	ARM64_CPYETRN   // MOPS
+	ARM64_CPYETWN   // MOPS
+	ARM64_CPYEWN    // MOPS
+	ARM64_CPYEWT    // MOPS
+	ARM64_CPYEWTN   // MOPS
+	ARM64_CPYEWTRN  // MOPS
+	ARM64_CPYEWTWN  // MOPS
+	ARM64_CPYFE     // MOPS
+	ARM64_CPYFEN    // MOPS
+	ARM64_CPYFERN   // MOPS
+	ARM64_CPYFERT   // MOPS
+	ARM64_CPYFERTN  // MOPS
+	ARM64_CPYFERTRN // MOPS
+	ARM64_CPYFERTWN // MOPS
+	ARM64_CPYFET    // MOPS
+	ARM64_CPYFETN   // MOPS
+	ARM64_CPYFETRN  // MOPS
+	ARM64_CPYFETWN  // MOPS
+	ARM64_CPYFEWN   // MOPS
+	ARM64_CPYFEWT   // MOPS
+	ARM64_CPYFEWTN  // MOPS
+	ARM64_CPYFEWTRN // MOPS
+	ARM64_CPYFEWTWN // MOPS
+	ARM64_CPYFM     // MOPS
+	ARM64_CPYFMN    // MOPS
+	ARM64_CPYFMRN   // MOPS
+	ARM64_CPYFMRT   // MOPS
+	ARM64_CPYFMRTN  // MOPS
+	ARM64_CPYFMRTRN // MOPS
+	ARM64_CPYFMRTWN // MOPS
+	ARM64_CPYFMT    // MOPS
+	ARM64_CPYFMTN   // MOPS
+	ARM64_CPYFMTRN  // MOPS
+	ARM64_CPYFMTWN  // MOPS
+	ARM64_CPYFMWN   // MOPS
+	ARM64_CPYFMWT   // MOPS
+	ARM64_CPYFMWTN  // MOPS
+	ARM64_CPYFMWTRN // MOPS
+	ARM64_CPYFMWTWN // MOPS
+	ARM64_CPYFP     // MOPS
+	ARM64_CPYFPN    // MOPS
+	ARM64_CPYFPRN   // MOPS
+	ARM64_CPYFPRT   // MOPS
+	ARM64_CPYFPRTN  // MOPS
+	ARM64_CPYFPRTRN // MOPS
+	ARM64_CPYFPRTWN // MOPS
+	ARM64_CPYFPT    // MOPS
+	ARM64_CPYFPTN   // MOPS
+	ARM64_CPYFPTRN  // MOPS
+	ARM64_CPYFPTWN  // MOPS
+	ARM64_CPYFPWN   // MOPS
+	ARM64_CPYFPWT   // MOPS
+	ARM64_CPYFPWTN  // MOPS
+	ARM64_CPYFPWTRN // MOPS
+	ARM64_CPYFPWTWN // MOPS
+	ARM64_CPYM      // MOPS
+	ARM64_CPYMN     // MOPS
+	ARM64_CPYMRN    // MOPS
+	ARM64_CPYMRT    // MOPS
+	ARM64_CPYMRTN   // MOPS
+	ARM64_CPYMRTRN  // MOPS
+	ARM64_CPYMRTWN  // MOPS
+	ARM64_CPYMT     // MOPS
+	ARM64_CPYMTN    // MOPS
+	ARM64_CPYMTRN   // MOPS
+	ARM64_CPYMTWN   // MOPS
+	ARM64_CPYMWN    // MOPS
+	ARM64_CPYMWT    // MOPS
+	ARM64_CPYMWTN   // MOPS
+	ARM64_CPYMWTRN  // MOPS
+	ARM64_CPYMWTWN  // MOPS
+	ARM64_CPYP      // MOPS
+	ARM64_CPYPN     // MOPS
+	ARM64_CPYPRN    // MOPS
+	ARM64_CPYPRT    // MOPS
+	ARM64_CPYPRTN   // MOPS
+	ARM64_CPYPRTRN  // MOPS
+	ARM64_CPYPRTWN  // MOPS
+	ARM64_CPYPT     // MOPS
+	ARM64_CPYPTN    // MOPS
+	ARM64_CPYPTRN   // MOPS
+	ARM64_CPYPTWN   // MOPS
+	ARM64_CPYPWN    // MOPS
+	ARM64_CPYPWT    // MOPS
+	ARM64_CPYPWTN   // MOPS
+	ARM64_CPYPWTRN  // MOPS
+	ARM64_CPYPWTWN  // MOPS
+	ARM64_SETE      // MOPS
+	ARM64_SETEN     // MOPS
+	ARM64_SETET     // MOPS
+	ARM64_SETETN    // MOPS
+	ARM64_SETGE     // MOPS
+	ARM64_SETGEN    // MOPS
+	ARM64_SETGET    // MOPS
+	ARM64_SETGETN   // MOPS
+	ARM64_SETGM     // MOPS
+	ARM64_SETGMN    // MOPS
+	ARM64_SETGMT    // MOPS
+	ARM64_SETGMTN   // MOPS
+	ARM64_SETGP     // MOPS
+	ARM64_SETGPN    // MOPS
+	ARM64_SETGPT    // MOPS
+	ARM64_SETGPTN   // MOPS
+	ARM64_SETM      // MOPS
+	ARM64_SETMN     // MOPS
+	ARM64_SETMT     // MOPS
+	ARM64_SETMTN    // MOPS
+	ARM64_SETP      // MOPS
+	ARM64_SETPN     // MOPS
+	ARM64_SETPT     // MOPS
+	ARM64_SETPTN    // MOPS
+
+	ARM64_AXFLAG // FlagM
+	ARM64_CFINV  // FlagM
+	ARM64_RMIF   // FlagM
+	ARM64_SETF16 // FlagM
+	ARM64_SETF8  // FlagM
+	ARM64_XAFLAG // FlagM
+
+	ARM64_LD64B   // LS64
+	ARM64_ST64B   // LS64
+	ARM64_ST64BV  // LS64
+	ARM64_ST64BV0 // LS64
+
+	ARM64_WFET // WFxT
+	ARM64_WFIT // WFxT
+
+	ARM64_CTZ // CSSC
+
+	ARM64_RPRFM // RPRFM
+
+	ARM64_GCSSTR     // GCS
+	ARM64_GCSSTTR    // GCS
+	ARM64_LDCLRP     // LSE128
+	ARM64_LDCLRPA    // LSE128
+	ARM64_LDCLRPAL   // LSE128
+	ARM64_LDCLRPL    // LSE128
+	ARM64_LDIAPP     // LRCPC3
+	ARM64_LDSETP     // LSE128
+	ARM64_LDSETPA    // LSE128
+	ARM64_LDSETPAL   // LSE128
+	ARM64_LDSETPL    // LSE128
+	ARM64_RCWCAS     // THE
+	ARM64_RCWCASA    // THE
+	ARM64_RCWCASAL   // THE
+	ARM64_RCWCASL    // THE
+	ARM64_RCWCASP    // THE
+	ARM64_RCWCASPA   // THE
+	ARM64_RCWCASPAL  // THE
+	ARM64_RCWCASPL   // THE
+	ARM64_RCWCLR     // THE
+	ARM64_RCWCLRA    // THE
+	ARM64_RCWCLRAL   // THE
+	ARM64_RCWCLRL    // THE
+	ARM64_RCWCLRP    // THE
+	ARM64_RCWCLRPA   // THE
+	ARM64_RCWCLRPAL  // THE
+	ARM64_RCWCLRPL   // THE
+	ARM64_RCWSCAS    // THE
+	ARM64_RCWSCASA   // THE
+	ARM64_RCWSCASAL  // THE
+	ARM64_RCWSCASL   // THE
+	ARM64_RCWSCASP   // THE
+	ARM64_RCWSCASPA  // THE
+	ARM64_RCWSCASPAL // THE
+	ARM64_RCWSCASPL  // THE
+	ARM64_RCWSCLR    // THE
+	ARM64_RCWSCLRA   // THE
+	ARM64_RCWSCLRAL  // THE
+	ARM64_RCWSCLRL   // THE
+	ARM64_RCWSCLRP   // THE
+	ARM64_RCWSCLRPA  // THE
+	ARM64_RCWSCLRPAL // THE
+	ARM64_RCWSCLRPL  // THE
+	ARM64_RCWSET     // THE
+	ARM64_RCWSETA    // THE
+	ARM64_RCWSETAL   // THE
+	ARM64_RCWSETL    // THE
+	ARM64_RCWSETP    // THE
+	ARM64_RCWSETPA   // THE
+	ARM64_RCWSETPAL  // THE
+	ARM64_RCWSETPL   // THE
+	ARM64_RCWSSET    // THE
+	ARM64_RCWSSETA   // THE
+	ARM64_RCWSSETAL  // THE
+	ARM64_RCWSSETL   // THE
+	ARM64_RCWSSETP   // THE
+	ARM64_RCWSSETPA  // THE
+	ARM64_RCWSSETPAL // THE
+	ARM64_RCWSSETPL  // THE
+	ARM64_RCWSSWP    // THE
+	ARM64_RCWSSWPA   // THE
+	ARM64_RCWSSWPAL  // THE
+	ARM64_RCWSSWPL   // THE
+	ARM64_RCWSSWPP   // THE
+	ARM64_RCWSSWPPA  // THE
+	ARM64_RCWSSWPPAL // THE
+	ARM64_RCWSSWPPL  // THE
+	ARM64_RCWSWP     // THE
+	ARM64_RCWSWPA    // THE
+	ARM64_RCWSWPAL   // THE
+	ARM64_RCWSWPL    // THE
+	ARM64_RCWSWPP    // THE
+	ARM64_RCWSWPPA   // THE
+	ARM64_RCWSWPPAL  // THE
+	ARM64_RCWSWPPL   // THE
+	ARM64_STILP      // LRCPC3
+	ARM64_SWPP       // LSE128
+	ARM64_SWPPA      // LSE128
+	ARM64_SWPPAL     // LSE128
+	ARM64_SWPPL      // LSE128
+
+	ARM64_GCSB     // GCS
+	ARM64_GCSPOPCX // GCS
+	ARM64_GCSPOPM  // GCS
+	ARM64_GCSPOPX  // GCS
+	ARM64_GCSPUSHM // GCS
+	ARM64_GCSPUSHX // GCS
+	ARM64_GCSSS1   // GCS
+	ARM64_GCSSS2   // GCS
+
+	ARM64_MRRS // D128
+	ARM64_MSRR // D128
+	ARM64_SYSP // D128
+
+	ARM64_BRB     // BRBE
+	ARM64_TCANCEL // TME
+	ARM64_TCOMMIT // TME
+	ARM64_TSTART  // TME
+	ARM64_TTEST   // TME
+
+	ARM64_SHA512H   // SHA512
+	ARM64_SHA512H2  // SHA512
+	ARM64_SHA512SU0 // SHA512
+	ARM64_SHA512SU1 // SHA512
+	ARM64_SM3PARTW1 // SM3
+	ARM64_SM3PARTW2 // SM3
+	ARM64_SM3SS1    // SM3
+	ARM64_SM3TT1A   // SM3
+	ARM64_SM3TT1B   // SM3
+	ARM64_SM3TT2A   // SM3
+	ARM64_SM3TT2B   // SM3
+
+	ARM64_AMXCLR    // Apple
+	ARM64_AMXEXTRX  // Apple
+	ARM64_AMXEXTRY  // Apple
+	ARM64_AMXFMA16  // Apple
+	ARM64_AMXFMA32  // Apple
+	ARM64_AMXFMA64  // Apple
+	ARM64_AMXFMS16  // Apple
+	ARM64_AMXFMS32  // Apple
+	ARM64_AMXFMS64  // Apple
+	ARM64_AMXGENLUT // Apple
+	ARM64_AMXLDX    // Apple
+	ARM64_AMXLDY    // Apple
+	ARM64_AMXLDZ    // Apple
+	ARM64_AMXLDZI   // Apple
+	ARM64_AMXMAC16  // Apple
+	ARM64_AMXMATFP  // Apple
+	ARM64_AMXMATINT // Apple
+	ARM64_AMXSET    // Apple
+	ARM64_AMXSTX    // Apple
+	ARM64_AMXSTY    // Apple
+	ARM64_AMXSTZ    // Apple
+	ARM64_AMXSTZI   // Apple
+	ARM64_AMXVECFP  // Apple
+	ARM64_AMXVECINT // Apple
+	ARM64_GENTER    // Apple
+	ARM64_GEXIT     // Apple
+	ARM64_SDSB      // Apple
+
 	AMD64_END_TYPE //Not real instruction
 )
 
@@ -1477,6 +2184,688 @@ func (o Operation) String() string {
 		"zip1",
 		"zip2",
 		"bti",
+		"addpl",              // SVE
+		"addvl",              // SVE
+		"andv",               // SVE
+		"asrd",               // SVE
+		"asrr",               // SVE
+		"clasta",             // SVE
+		"clastb",             // SVE
+		"cnot",               // SVE
+		"cntb",               // SVE
+		"cntd",               // SVE
+		"cnth",               // SVE
+		"cntw",               // SVE
+		"compact",            // SVE
+		"decb",               // SVE
+		"decd",               // SVE
+		"dech",               // SVE
+		"decw",               // SVE
+		"dupm",               // SVE
+		"eorv",               // SVE
+		"fexpa",              // SVE
+		"ftssel",             // SVE
+		"incb",               // SVE
+		"incd",               // SVE
+		"inch",               // SVE
+		"incw",               // SVE
+		"index",              // SVE
+		"insr",               // SVE
+		"lasta",              // SVE
+		"lastb",              // SVE
+		"lslr",               // SVE
+		"lsrr",               // SVE
+		"mad",                // SVE
+		"movprfx",            // SVE
+		"msb",                // SVE
+		"orv",                // SVE
+		"punpkhi",            // SVE
+		"punpklo",            // SVE
+		"rdvl",               // SVE
+		"revb",               // SVE
+		"revh",               // SVE
+		"revw",               // SVE
+		"saddv",              // SVE
+		"sdivr",              // SVE
+		"sel",                // SVE
+		"splice",             // SVE
+		"sqdecb",             // SVE
+		"sqdecd",             // SVE
+		"sqdech",             // SVE
+		"sqdecw",             // SVE
+		"sqincb",             // SVE
+		"sqincd",             // SVE
+		"sqinch",             // SVE
+		"sqincw",             // SVE
+		"subr",               // SVE
+		"sunpkhi",            // SVE
+		"sunpklo",            // SVE
+		"uaddv",              // SVE
+		"udivr",              // SVE
+		"uqdecb",             // SVE
+		"uqdecd",             // SVE
+		"uqdech",             // SVE
+		"uqdecw",             // SVE
+		"uqincb",             // SVE
+		"uqincd",             // SVE
+		"uqinch",             // SVE
+		"uqincw",             // SVE
+		"uunpkhi",            // SVE
+		"uunpklo",            // SVE
+		"uxtw",               // SVE
+		"brka",               // SVE
+		"brkas",              // SVE
+		"brkb",               // SVE
+		"brkbs",              // SVE
+		"brkn",               // SVE
+		"brkns",              // SVE
+		"brkpa",              // SVE
+		"brkpas",             // SVE
+		"brkpb",              // SVE
+		"brkpbs",             // SVE
+		"cmpeq",              // SVE
+		"cmpge",              // SVE
+		"cmpgt",              // SVE
+		"cmphi",              // SVE
+		"cmphs",              // SVE
+		"cmple",              // SVE
+		"cmplo",              // SVE
+		"cmpls",              // SVE
+		"cmplt",              // SVE
+		"cmpne",              // SVE
+		"cntp",               // SVE
+		"ctermeq",            // SVE
+		"ctermne",            // SVE
+		"decp",               // SVE
+		"eors",               // SVE
+		"incp",               // SVE
+		"movs",               // SVE
+		"nand",               // SVE
+		"nands",              // SVE
+		"nor",                // SVE
+		"nors",               // SVE
+		"nots",               // SVE
+		"orns",               // SVE
+		"orrs",               // SVE
+		"pfalse",             // SVE
+		"pfirst",             // SVE
+		"pnext",              // SVE
+		"ptest",              // SVE
+		"ptrue",              // SVE
+		"ptrues",             // SVE
+		"rdffr",              // SVE
+		"rdffrs",             // SVE
+		"setffr",             // SVE
+		"sqdecp",             // SVE
+		"sqincp",             // SVE
+		"uqdecp",             // SVE
+		"uqincp",             // SVE
+		"whilele",            // SVE
+		"whilelo",            // SVE
+		"whilels",            // SVE
+		"whilelt",            // SVE
+		"wrffr",              // SVE
+		"fadda",              // SVE
+		"faddv",              // SVE
+		"fcmne",              // SVE
+		"fcmuo",              // SVE
+		"fdivr",              // SVE
+		"fmad",               // SVE
+		"fmsb",               // SVE
+		"fnmad",              // SVE
+		"fnmla",              // SVE
+		"fnmls",              // SVE
+		"fnmsb",              // SVE
+		"fscale",             // SVE
+		"fsubr",              // SVE
+		"ftmad",              // SVE
+		"ftsmul",             // SVE
+		"ld1b",               // SVE
+		"ld1d",               // SVE
+		"ld1h",               // SVE
+		"ld1rb",              // SVE
+		"ld1rd",              // SVE
+		"ld1rh",              // SVE
+		"ld1rob",             // SVE
+		"ld1rod",             // SVE
+		"ld1roh",             // SVE
+		"ld1row",             // SVE
+		"ld1rqb",             // SVE
+		"ld1rqd",             // SVE
+		"ld1rqh",             // SVE
+		"ld1rqw",             // SVE
+		"ld1rsb",             // SVE
+		"ld1rsh",             // SVE
+		"ld1rsw",             // SVE
+		"ld1rw",              // SVE
+		"ld1sb",              // SVE
+		"ld1sh",              // SVE
+		"ld1sw",              // SVE
+		"ld1w",               // SVE
+		"ld2b",               // SVE
+		"ld2d",               // SVE
+		"ld2h",               // SVE
+		"ld2w",               // SVE
+		"ld3b",               // SVE
+		"ld3d",               // SVE
+		"ld3h",               // SVE
+		"ld3w",               // SVE
+		"ld4b",               // SVE
+		"ld4d",               // SVE
+		"ld4h",               // SVE
+		"ld4w",               // SVE
+		"ldff1b",             // SVE
+		"ldff1d",             // SVE
+		"ldff1h",             // SVE
+		"ldff1sb",            // SVE
+		"ldff1sh",            // SVE
+		"ldff1sw",            // SVE
+		"ldff1w",             // SVE
+		"ldnf1b",             // SVE
+		"ldnf1d",             // SVE
+		"ldnf1h",             // SVE
+		"ldnf1sb",            // SVE
+		"ldnf1sh",            // SVE
+		"ldnf1sw",            // SVE
+		"ldnf1w",             // SVE
+		"ldnt1b",             // SVE
+		"ldnt1d",             // SVE
+		"ldnt1h",             // SVE
+		"ldnt1w",             // SVE
+		"prfb",               // SVE
+		"prfd",               // SVE
+		"prfh",               // SVE
+		"prfw",               // SVE
+		"st1b",               // SVE
+		"st1d",               // SVE
+		"st1h",               // SVE
+		"st1w",               // SVE
+		"st2b",               // SVE
+		"st2d",               // SVE
+		"st2h",               // SVE
+		"st2w",               // SVE
+		"st3b",               // SVE
+		"st3d",               // SVE
+		"st3h",               // SVE
+		"st3w",               // SVE
+		"st4b",               // SVE
+		"st4d",               // SVE
+		"st4h",               // SVE
+		"st4w",               // SVE
+		"stnt1b",             // SVE
+		"stnt1d",             // SVE
+		"stnt1h",             // SVE
+		"stnt1w",             // SVE
+		"adclb",              // SVE2
+		"adclt",              // SVE2
+		"addhnb",             // SVE2
+		"addhnt",             // SVE2
+		"bdep",               // SVE2
+		"bext",               // SVE2
+		"bgrp",               // SVE2
+		"cadd",               // SVE2
+		"cdot",               // SVE2
+		"cmla",               // SVE2
+		"eorbt",              // SVE2
+		"eortb",              // SVE2
+		"histcnt",            // SVE2
+		"histseg",            // SVE2
+		"match",              // SVE2
+		"nmatch",             // SVE2
+		"pmullb",             // SVE2
+		"pmullt",             // SVE2
+		"raddhnb",            // SVE2
+		"raddhnt",            // SVE2
+		"rax1",               // SVE2
+		"rshrnb",             // SVE2
+		"rshrnt",             // SVE2
+		"rsubhnb",            // SVE2
+		"rsubhnt",            // SVE2
+		"sabalb",             // SVE2
+		"sabalt",             // SVE2
+		"sabdlb",             // SVE2
+		"sabdlt",             // SVE2
+		"saddlb",             // SVE2
+		"saddlbt",            // SVE2
+		"saddlt",             // SVE2
+		"saddwb",             // SVE2
+		"saddwt",             // SVE2
+		"sbclb",              // SVE2
+		"sbclt",              // SVE2
+		"sclamp",             // SVE2
+		"shrnb",              // SVE2
+		"shrnt",              // SVE2
+		"shsubr",             // SVE2
+		"sm4e",               // SVE2
+		"sm4ekey",            // SVE2
+		"smlalb",             // SVE2
+		"smlalt",             // SVE2
+		"smlslb",             // SVE2
+		"smlslt",             // SVE2
+		"smullb",             // SVE2
+		"smullt",             // SVE2
+		"sqcadd",             // SVE2
+		"sqcvtn",             // SVE2
+		"sqcvtun",            // SVE2
+		"sqdmlalb",           // SVE2
+		"sqdmlalbt",          // SVE2
+		"sqdmlalt",           // SVE2
+		"sqdmlslb",           // SVE2
+		"sqdmlslbt",          // SVE2
+		"sqdmlslt",           // SVE2
+		"sqdmullb",           // SVE2
+		"sqdmullt",           // SVE2
+		"sqrdcmlah",          // SVE2
+		"sqrshlr",            // SVE2
+		"sqrshrnb",           // SVE2
+		"sqrshrnt",           // SVE2
+		"sqrshrunb",          // SVE2
+		"sqrshrunt",          // SVE2
+		"sqshlr",             // SVE2
+		"sqshrnb",            // SVE2
+		"sqshrnt",            // SVE2
+		"sqshrunb",           // SVE2
+		"sqshrunt",           // SVE2
+		"sqsubr",             // SVE2
+		"sqxtnb",             // SVE2
+		"sqxtnt",             // SVE2
+		"sqxtunb",            // SVE2
+		"sqxtunt",            // SVE2
+		"srshlr",             // SVE2
+		"sshllb",             // SVE2
+		"sshllt",             // SVE2
+		"ssublb",             // SVE2
+		"ssublbt",            // SVE2
+		"ssublt",             // SVE2
+		"ssubltb",            // SVE2
+		"ssubwb",             // SVE2
+		"ssubwt",             // SVE2
+		"subhnb",             // SVE2
+		"subhnt",             // SVE2
+		"tblq",               // SVE2
+		"uabalb",             // SVE2
+		"uabalt",             // SVE2
+		"uabdlb",             // SVE2
+		"uabdlt",             // SVE2
+		"uaddlb",             // SVE2
+		"uaddlt",             // SVE2
+		"uaddwb",             // SVE2
+		"uaddwt",             // SVE2
+		"uclamp",             // SVE2
+		"uhsubr",             // SVE2
+		"umlalb",             // SVE2
+		"umlalt",             // SVE2
+		"umlslb",             // SVE2
+		"umlslt",             // SVE2
+		"umullb",             // SVE2
+		"umullt",             // SVE2
+		"uqcvtn",             // SVE2
+		"uqrshlr",            // SVE2
+		"uqrshrnb",           // SVE2
+		"uqrshrnt",           // SVE2
+		"uqshlr",             // SVE2
+		"uqshrnb",            // SVE2
+		"uqshrnt",            // SVE2
+		"uqsubr",             // SVE2
+		"uqxtnb",             // SVE2
+		"uqxtnt",             // SVE2
+		"urshlr",             // SVE2
+		"ushllb",             // SVE2
+		"ushllt",             // SVE2
+		"usublb",             // SVE2
+		"usublt",             // SVE2
+		"usubwb",             // SVE2
+		"usubwt",             // SVE2
+		"uzpq1",              // SVE2
+		"uzpq2",              // SVE2
+		"zipq1",              // SVE2
+		"zipq2",              // SVE2
+		"addqv",              // SVE2
+		"andqv",              // SVE2
+		"dupq",               // SVE2
+		"eorqv",              // SVE2
+		"extq",               // SVE2
+		"orqv",               // SVE2
+		"pmov",               // SVE2
+		"revd",               // SVE2
+		"smaxqv",             // SVE2
+		"sminqv",             // SVE2
+		"tbxq",               // SVE2
+		"umaxqv",             // SVE2
+		"uminqv",             // SVE2
+		"bcax",               // SVE2
+		"bsl1n",              // SVE2
+		"bsl2n",              // SVE2
+		"eor3",               // SVE2
+		"nbsl",               // SVE2
+		"xar",                // SVE2
+		"pext",               // SVE2
+		"psel",               // SVE2
+		"whilege",            // SVE2
+		"whilegt",            // SVE2
+		"whilehi",            // SVE2
+		"whilehs",            // SVE2
+		"whilerw",            // SVE2
+		"whilewr",            // SVE2
+		"bfcvtnt",            // SVE2
+		"bfmlslb",            // SVE2
+		"bfmlslt",            // SVE2
+		"faddqv",             // SVE2
+		"fclamp",             // SVE2
+		"fcvtlt",             // SVE2
+		"fcvtnt",             // SVE2
+		"fcvtxnt",            // SVE2
+		"fdot",               // SVE2
+		"fmaxnmqv",           // SVE2
+		"fmaxqv",             // SVE2
+		"fminnmqv",           // SVE2
+		"fminqv",             // SVE2
+		"fmlalb",             // SVE2
+		"fmlalt",             // SVE2
+		"fmlslb",             // SVE2
+		"fmlslt",             // SVE2
+		"fmmla",              // SVE2
+		"fcvtx",              // SVE2
+		"flogb",              // SVE2
+		"ldnt1sb",            // SVE2
+		"ldnt1sh",            // SVE2
+		"ldnt1sw",            // SVE2
+		"ld1q",               // SVE2
+		"ld2q",               // SVE2
+		"ld3q",               // SVE2
+		"ld4q",               // SVE2
+		"st1q",               // SVE2
+		"st2q",               // SVE2
+		"st3q",               // SVE2
+		"st4q",               // SVE2
+		"addha",              // SME
+		"addspl",             // SME
+		"addsvl",             // SME
+		"addva",              // SME
+		"bfmopa",             // SME
+		"bfmops",             // SME
+		"fmopa",              // SME
+		"fmops",              // SME
+		"rdsvl",              // SME
+		"smopa",              // SME
+		"smops",              // SME
+		"smstart",            // SME
+		"smstop",             // SME
+		"sumopa",             // SME
+		"sumops",             // SME
+		"umopa",              // SME
+		"umops",              // SME
+		"usmopa",             // SME
+		"usmops",             // SME
+		"zero",               // SME
+		"cpye",               // MOPS
+		"cpyen",              // MOPS
+		"cpyern",             // MOPS
+		"cpyert",             // MOPS
+		"cpyertn",            // MOPS
+		"cpyertrn",           // MOPS
+		"cpyertwn",           // MOPS
+		"cpyet",              // MOPS
+		"cpyetn",             // MOPS
+		"cpyetrn",            // MOPS
+		"cpyetwn",            // MOPS
+		"cpyewn",             // MOPS
+		"cpyewt",             // MOPS
+		"cpyewtn",            // MOPS
+		"cpyewtrn",           // MOPS
+		"cpyewtwn",           // MOPS
+		"cpyfe",              // MOPS
+		"cpyfen",             // MOPS
+		"cpyfern",            // MOPS
+		"cpyfert",            // MOPS
+		"cpyfertn",           // MOPS
+		"cpyfertrn",          // MOPS
+		"cpyfertwn",          // MOPS
+		"cpyfet",             // MOPS
+		"cpyfetn",            // MOPS
+		"cpyfetrn",           // MOPS
+		"cpyfetwn",           // MOPS
+		"cpyfewn",            // MOPS
+		"cpyfewt",            // MOPS
+		"cpyfewtn",           // MOPS
+		"cpyfewtrn",          // MOPS
+		"cpyfewtwn",          // MOPS
+		"cpyfm",              // MOPS
+		"cpyfmn",             // MOPS
+		"cpyfmrn",            // MOPS
+		"cpyfmrt",            // MOPS
+		"cpyfmrtn",           // MOPS
+		"cpyfmrtrn",          // MOPS
+		"cpyfmrtwn",          // MOPS
+		"cpyfmt",             // MOPS
+		"cpyfmtn",            // MOPS
+		"cpyfmtrn",           // MOPS
+		"cpyfmtwn",           // MOPS
+		"cpyfmwn",            // MOPS
+		"cpyfmwt",            // MOPS
+		"cpyfmwtn",           // MOPS
+		"cpyfmwtrn",          // MOPS
+		"cpyfmwtwn",          // MOPS
+		"cpyfp",              // MOPS
+		"cpyfpn",             // MOPS
+		"cpyfprn",            // MOPS
+		"cpyfprt",            // MOPS
+		"cpyfprtn",           // MOPS
+		"cpyfprtrn",          // MOPS
+		"cpyfprtwn",          // MOPS
+		"cpyfpt",             // MOPS
+		"cpyfptn",            // MOPS
+		"cpyfptrn",           // MOPS
+		"cpyfptwn",           // MOPS
+		"cpyfpwn",            // MOPS
+		"cpyfpwt",            // MOPS
+		"cpyfpwtn",           // MOPS
+		"cpyfpwtrn",          // MOPS
+		"cpyfpwtwn",          // MOPS
+		"cpym",               // MOPS
+		"cpymn",              // MOPS
+		"cpymrn",             // MOPS
+		"cpymrt",             // MOPS
+		"cpymrtn",            // MOPS
+		"cpymrtrn",           // MOPS
+		"cpymrtwn",           // MOPS
+		"cpymt",              // MOPS
+		"cpymtn",             // MOPS
+		"cpymtrn",            // MOPS
+		"cpymtwn",            // MOPS
+		"cpymwn",             // MOPS
+		"cpymwt",             // MOPS
+		"cpymwtn",            // MOPS
+		"cpymwtrn",           // MOPS
+		"cpymwtwn",           // MOPS
+		"cpyp",               // MOPS
+		"cpypn",              // MOPS
+		"cpyprn",             // MOPS
+		"cpyprt",             // MOPS
+		"cpyprtn",            // MOPS
+		"cpyprtrn",           // MOPS
+		"cpyprtwn",           // MOPS
+		"cpypt",              // MOPS
+		"cpyptn",             // MOPS
+		"cpyptrn",            // MOPS
+		"cpyptwn",            // MOPS
+		"cpypwn",             // MOPS
+		"cpypwt",             // MOPS
+		"cpypwtn",            // MOPS
+		"cpypwtrn",           // MOPS
+		"cpypwtwn",           // MOPS
+		"sete",               // MOPS
+		"seten",              // MOPS
+		"setet",              // MOPS
+		"setetn",             // MOPS
+		"setge",              // MOPS
+		"setgen",             // MOPS
+		"setget",             // MOPS
+		"setgetn",            // MOPS
+		"setgm",              // MOPS
+		"setgmn",             // MOPS
+		"setgmt",             // MOPS
+		"setgmtn",            // MOPS
+		"setgp",              // MOPS
+		"setgpn",             // MOPS
+		"setgpt",             // MOPS
+		"setgptn",            // MOPS
+		"setm",               // MOPS
+		"setmn",              // MOPS
+		"setmt",              // MOPS
+		"setmtn",             // MOPS
+		"setp",               // MOPS
+		"setpn",              // MOPS
+		"setpt",              // MOPS
+		"setptn",             // MOPS
+		"axflag",             // FlagM
+		"cfinv",              // FlagM
+		"rmif",               // FlagM
+		"setf16",             // FlagM
+		"setf8",              // FlagM
+		"xaflag",             // FlagM
+		"ld64b",              // LS64
+		"st64b",              // LS64
+		"st64bv",             // LS64
+		"st64bv0",            // LS64
+		"wfet",               // WFxT
+		"wfit",               // WFxT
+		"ctz",                // CSSC
+		"rprfm",              // RPRFM
+		"gcsstr",             // GCS
+		"gcssttr",            // GCS
+		"ldclrp",             // LSE128
+		"ldclrpa",            // LSE128
+		"ldclrpal",           // LSE128
+		"ldclrpl",            // LSE128
+		"ldiapp",             // LRCPC3
+		"ldsetp",             // LSE128
+		"ldsetpa",            // LSE128
+		"ldsetpal",           // LSE128
+		"ldsetpl",            // LSE128
+		"rcwcas",             // THE
+		"rcwcasa",            // THE
+		"rcwcasal",           // THE
+		"rcwcasl",            // THE
+		"rcwcasp",            // THE
+		"rcwcaspa",           // THE
+		"rcwcaspal",          // THE
+		"rcwcaspl",           // THE
+		"rcwclr",             // THE
+		"rcwclra",            // THE
+		"rcwclral",           // THE
+		"rcwclrl",            // THE
+		"rcwclrp",            // THE
+		"rcwclrpa",           // THE
+		"rcwclrpal",          // THE
+		"rcwclrpl",           // THE
+		"rcwscas",            // THE
+		"rcwscasa",           // THE
+		"rcwscasal",          // THE
+		"rcwscasl",           // THE
+		"rcwscasp",           // THE
+		"rcwscaspa",          // THE
+		"rcwscaspal",         // THE
+		"rcwscaspl",          // THE
+		"rcwsclr",            // THE
+		"rcwsclra",           // THE
+		"rcwsclral",          // THE
+		"rcwsclrl",           // THE
+		"rcwsclrp",           // THE
+		"rcwsclrpa",          // THE
+		"rcwsclrpal",         // THE
+		"rcwsclrpl",          // THE
+		"rcwset",             // THE
+		"rcwseta",            // THE
+		"rcwsetal",           // THE
+		"rcwsetl",            // THE
+		"rcwsetp",            // THE
+		"rcwsetpa",           // THE
+		"rcwsetpal",          // THE
+		"rcwsetpl",           // THE
+		"rcwsset",            // THE
+		"rcwsseta",           // THE
+		"rcwssetal",          // THE
+		"rcwssetl",           // THE
+		"rcwssetp",           // THE
+		"rcwssetpa",          // THE
+		"rcwssetpal",         // THE
+		"rcwssetpl",          // THE
+		"rcwsswp",            // THE
+		"rcwsswpa",           // THE
+		"rcwsswpal",          // THE
+		"rcwsswpl",           // THE
+		"rcwsswpp",           // THE
+		"rcwsswppa",          // THE
+		"rcwsswppal",         // THE
+		"rcwsswppl",          // THE
+		"rcwswp",             // THE
+		"rcwswpa",            // THE
+		"rcwswpal",           // THE
+		"rcwswpl",            // THE
+		"rcwswpp",            // THE
+		"rcwswppa",           // THE
+		"rcwswppal",          // THE
+		"rcwswppl",           // THE
+		"stilp",              // LRCPC3
+		"swpp",               // LSE128
+		"swppa",              // LSE128
+		"swppal",             // LSE128
+		"swppl",              // LSE128
+		"gcsb",               // GCS
+		"gcspopcx",           // GCS
+		"gcspopm",            // GCS
+		"gcspopx",            // GCS
+		"gcspushm",           // GCS
+		"gcspushx",           // GCS
+		"gcsss1",             // GCS
+		"gcsss2",             // GCS
+		"mrrs",               // D128
+		"msrr",               // D128
+		"sysp",               // D128
+		"brb",                // BRBE
+		"tcancel",            // TME
+		"tcommit",            // TME
+		"tstart",             // TME
+		"ttest",              // TME
+		"sha512h",            // SHA512
+		"sha512h2",           // SHA512
+		"sha512su0",          // SHA512
+		"sha512su1",          // SHA512
+		"sm3partw1",          // SM3
+		"sm3partw2",          // SM3
+		"sm3ss1",             // SM3
+		"sm3tt1a",            // SM3
+		"sm3tt1b",            // SM3
+		"sm3tt2a",            // SM3
+		"sm3tt2b",            // SM3
+		"amxclr",             // Apple
+		"amxextrx",           // Apple
+		"amxextry",           // Apple
+		"amxfma16",           // Apple
+		"amxfma32",           // Apple
+		"amxfma64",           // Apple
+		"amxfms16",           // Apple
+		"amxfms32",           // Apple
+		"amxfms64",           // Apple
+		"amxgenlut",          // Apple
+		"amxldx",             // Apple
+		"amxldy",             // Apple
+		"amxldz",             // Apple
+		"amxldzi",            // Apple
+		"amxmac16",           // Apple
+		"amxmatfp",           // Apple
+		"amxmatint",          // Apple
+		"amxset",             // Apple
+		"amxstx",             // Apple
+		"amxsty",             // Apple
+		"amxstz",             // Apple
+		"amxstzi",            // Apple
+		"amxvecfp",           // Apple
+		"amxvecint",          // Apple
+		"genter",             // Apple
+		"gexit",              // Apple
+		"sdsb",               // Apple
 		"END_OPERATION_LIST", //NOT AN INSTRUCTION
 	}[o]
 }
@@ -1551,6 +2940,30 @@ func (i AddSubImmTags) Padding() uint32 {
 	return ExtractBits(uint32(i), 22, 10)
 }
 
+type MinMaxImm uint32
+
+func (i MinMaxImm) Rd() uint32 {
+	return ExtractBits(uint32(i), 0, 5)
+}
+func (i MinMaxImm) Rn() uint32 {
+	return ExtractBits(uint32(i), 5, 5)
+}
+func (i MinMaxImm) Imm8() uint32 {
+	return ExtractBits(uint32(i), 10, 8)
+}
+func (i MinMaxImm) Opc() uint32 {
+	return ExtractBits(uint32(i), 18, 4)
+}
+func (i MinMaxImm) S() uint32 {
+	return ExtractBits(uint32(i), 29, 1)
+}
+func (i MinMaxImm) Op() uint32 {
+	return ExtractBits(uint32(i), 30, 1)
+}
+func (i MinMaxImm) Sf() uint32 {
+	return ExtractBits(uint32(i), 31, 1)
+}
+
 type LogicalImm uint32
 
 func (i LogicalImm) Rd() uint32 {
@@ -2448,6 +3861,9 @@ func (i LdstAtomic) R() uint32 {
 func (i LdstAtomic) A() uint32 {
 	return ExtractBits(uint32(i), 23, 1)
 }
+func (i LdstAtomic) V() uint32 {
+	return ExtractBits(uint32(i), 26, 1)
+}
 func (i LdstAtomic) Group() uint32 {
 	return ExtractBits(uint32(i), 24, 6)
 }
@@ -3984,6 +5400,114 @@ func (i Cryptographic2RegSha) Group3() uint32 {
 	return ExtractBits(uint32(i), 24, 8)
 }
 
+type Cryptographic3RegImm2 uint32
+
+func (i Cryptographic3RegImm2) Rd() uint32 {
+	return ExtractBits(uint32(i), 0, 5)
+}
+func (i Cryptographic3RegImm2) Rn() uint32 {
+	return ExtractBits(uint32(i), 5, 5)
+}
+func (i Cryptographic3RegImm2) Opcode() uint32 {
+	return ExtractBits(uint32(i), 10, 2)
+}
+func (i Cryptographic3RegImm2) Imm2() uint32 {
+	return ExtractBits(uint32(i), 12, 2)
+}
+func (i Cryptographic3RegImm2) Group1() uint32 {
+	return ExtractBits(uint32(i), 14, 2)
+}
+func (i Cryptographic3RegImm2) Rm() uint32 {
+	return ExtractBits(uint32(i), 16, 5)
+}
+func (i Cryptographic3RegImm2) Group2() uint32 {
+	return ExtractBits(uint32(i), 21, 11)
+}
+
+type Cryptographic3RegSha512 uint32
+
+func (i Cryptographic3RegSha512) Rd() uint32 {
+	return ExtractBits(uint32(i), 0, 5)
+}
+func (i Cryptographic3RegSha512) Rn() uint32 {
+	return ExtractBits(uint32(i), 5, 5)
+}
+func (i Cryptographic3RegSha512) Opcode() uint32 {
+	return ExtractBits(uint32(i), 10, 2)
+}
+func (i Cryptographic3RegSha512) Group1() uint32 {
+	return ExtractBits(uint32(i), 12, 2)
+}
+func (i Cryptographic3RegSha512) O() uint32 {
+	return ExtractBits(uint32(i), 14, 1)
+}
+func (i Cryptographic3RegSha512) Group2() uint32 {
+	return ExtractBits(uint32(i), 15, 1)
+}
+func (i Cryptographic3RegSha512) Rm() uint32 {
+	return ExtractBits(uint32(i), 16, 5)
+}
+func (i Cryptographic3RegSha512) Group3() uint32 {
+	return ExtractBits(uint32(i), 21, 11)
+}
+
+type Cryptographic4Reg uint32
+
+func (i Cryptographic4Reg) Rd() uint32 {
+	return ExtractBits(uint32(i), 0, 5)
+}
+func (i Cryptographic4Reg) Rn() uint32 {
+	return ExtractBits(uint32(i), 5, 5)
+}
+func (i Cryptographic4Reg) Ra() uint32 {
+	return ExtractBits(uint32(i), 10, 5)
+}
+func (i Cryptographic4Reg) Group1() uint32 {
+	return ExtractBits(uint32(i), 15, 1)
+}
+func (i Cryptographic4Reg) Rm() uint32 {
+	return ExtractBits(uint32(i), 16, 5)
+}
+func (i Cryptographic4Reg) Op0() uint32 {
+	return ExtractBits(uint32(i), 21, 2)
+}
+func (i Cryptographic4Reg) Group2() uint32 {
+	return ExtractBits(uint32(i), 23, 9)
+}
+
+type CryptographicXar uint32
+
+func (i CryptographicXar) Rd() uint32 {
+	return ExtractBits(uint32(i), 0, 5)
+}
+func (i CryptographicXar) Rn() uint32 {
+	return ExtractBits(uint32(i), 5, 5)
+}
+func (i CryptographicXar) Imm6() uint32 {
+	return ExtractBits(uint32(i), 10, 6)
+}
+func (i CryptographicXar) Rm() uint32 {
+	return ExtractBits(uint32(i), 16, 5)
+}
+func (i CryptographicXar) Group1() uint32 {
+	return ExtractBits(uint32(i), 21, 11)
+}
+
+type Cryptographic2RegSha512 uint32
+
+func (i Cryptographic2RegSha512) Rd() uint32 {
+	return ExtractBits(uint32(i), 0, 5)
+}
+func (i Cryptographic2RegSha512) Rn() uint32 {
+	return ExtractBits(uint32(i), 5, 5)
+}
+func (i Cryptographic2RegSha512) Opcode() uint32 {
+	return ExtractBits(uint32(i), 10, 2)
+}
+func (i Cryptographic2RegSha512) Group1() uint32 {
+	return ExtractBits(uint32(i), 12, 20)
+}
+
 type PointerAuth uint32
 
 func (i PointerAuth) Rd() uint32 {
@@ -4121,6 +5645,7 @@ const (
 	REG_CPTR_EL3
 	REG_CSSELR_EL1
 	REG_CSYNC
+	REG_DSYNC
 	REG_CSW
 	REG_CGSW
 	REG_CGDSW
@@ -4640,6 +6165,134 @@ const (
 	REG_CNTPCTSS_EL0
 	REG_UAO
 
+	REG_SVCR
+	REG_TPIDR2_EL0
+	REG_SMCR_EL1
+	REG_SMCR_EL2
+	REG_SMCR_EL12
+	REG_SMCR_EL3
+	REG_SMPRI_EL1
+	REG_SMPRIMAP_EL2
+	REG_SMIDR_EL1
+	REG_ID_AA64SMFR0_EL1
+	REG_SVCRSM
+	REG_SVCRZA
+
+	REG_GCSCR_EL1
+	REG_GCSPR_EL1
+	REG_GCSCRE0_EL1
+	REG_GCSPR_EL0
+	REG_RCWMASK_EL1
+	REG_RCWSMASK_EL1
+
+	REG_BRBINF0_EL1
+	REG_BRBINF1_EL1
+	REG_BRBINF2_EL1
+	REG_BRBINF3_EL1
+	REG_BRBINF4_EL1
+	REG_BRBINF5_EL1
+	REG_BRBINF6_EL1
+	REG_BRBINF7_EL1
+	REG_BRBINF8_EL1
+	REG_BRBINF9_EL1
+	REG_BRBINF10_EL1
+	REG_BRBINF11_EL1
+	REG_BRBINF12_EL1
+	REG_BRBINF13_EL1
+	REG_BRBINF14_EL1
+	REG_BRBINF15_EL1
+	REG_BRBINF16_EL1
+	REG_BRBINF17_EL1
+	REG_BRBINF18_EL1
+	REG_BRBINF19_EL1
+	REG_BRBINF20_EL1
+	REG_BRBINF21_EL1
+	REG_BRBINF22_EL1
+	REG_BRBINF23_EL1
+	REG_BRBINF24_EL1
+	REG_BRBINF25_EL1
+	REG_BRBINF26_EL1
+	REG_BRBINF27_EL1
+	REG_BRBINF28_EL1
+	REG_BRBINF29_EL1
+	REG_BRBINF30_EL1
+	REG_BRBINF31_EL1
+	REG_BRBSRC0_EL1
+	REG_BRBSRC1_EL1
+	REG_BRBSRC2_EL1
+	REG_BRBSRC3_EL1
+	REG_BRBSRC4_EL1
+	REG_BRBSRC5_EL1
+	REG_BRBSRC6_EL1
+	REG_BRBSRC7_EL1
+	REG_BRBSRC8_EL1
+	REG_BRBSRC9_EL1
+	REG_BRBSRC10_EL1
+	REG_BRBSRC11_EL1
+	REG_BRBSRC12_EL1
+	REG_BRBSRC13_EL1
+	REG_BRBSRC14_EL1
+	REG_BRBSRC15_EL1
+	REG_BRBSRC16_EL1
+	REG_BRBSRC17_EL1
+	REG_BRBSRC18_EL1
+	REG_BRBSRC19_EL1
+	REG_BRBSRC20_EL1
+	REG_BRBSRC21_EL1
+	REG_BRBSRC22_EL1
+	REG_BRBSRC23_EL1
+	REG_BRBSRC24_EL1
+	REG_BRBSRC25_EL1
+	REG_BRBSRC26_EL1
+	REG_BRBSRC27_EL1
+	REG_BRBSRC28_EL1
+	REG_BRBSRC29_EL1
+	REG_BRBSRC30_EL1
+	REG_BRBSRC31_EL1
+	REG_BRBTGT0_EL1
+	REG_BRBTGT1_EL1
+	REG_BRBTGT2_EL1
+	REG_BRBTGT3_EL1
+	REG_BRBTGT4_EL1
+	REG_BRBTGT5_EL1
+	REG_BRBTGT6_EL1
+	REG_BRBTGT7_EL1
+	REG_BRBTGT8_EL1
+	REG_BRBTGT9_EL1
+	REG_BRBTGT10_EL1
+	REG_BRBTGT11_EL1
+	REG_BRBTGT12_EL1
+	REG_BRBTGT13_EL1
+	REG_BRBTGT14_EL1
+	REG_BRBTGT15_EL1
+	REG_BRBTGT16_EL1
+	REG_BRBTGT17_EL1
+	REG_BRBTGT18_EL1
+	REG_BRBTGT19_EL1
+	REG_BRBTGT20_EL1
+	REG_BRBTGT21_EL1
+	REG_BRBTGT22_EL1
+	REG_BRBTGT23_EL1
+	REG_BRBTGT24_EL1
+	REG_BRBTGT25_EL1
+	REG_BRBTGT26_EL1
+	REG_BRBTGT27_EL1
+	REG_BRBTGT28_EL1
+	REG_BRBTGT29_EL1
+	REG_BRBTGT30_EL1
+	REG_BRBTGT31_EL1
+	REG_BRBCR_EL1
+	REG_BRBCR_EL2
+	REG_BRBCR_EL12
+	REG_BRBFCR_EL1
+	REG_BRBTS_EL1
+	REG_BRBINFINJ_EL1
+	REG_BRBSRCINJ_EL1
+	REG_BRBTGTINJ_EL1
+	REG_BRBIDR0_EL1
+	REG_IALL
+	REG_INJ
+
 	REG_END_REG
 )
 
@@ -4761,6 +6414,7 @@ func (s SystemReg) String() string {
 		"cptr_el3",
 		"csselr_el1",
 		"csync",
+		"dsync",
 		"csw",
 		"cgsw",
 		"cgdsw",
@@ -5283,6 +6937,134 @@ func (s SystemReg) String() string {
 
 		"uao",
 
+		"svcr",
+		"tpidr2_el0",
+		"smcr_el1",
+		"smcr_el2",
+		"smcr_el12",
+		"smcr_el3",
+		"smpri_el1",
+		"smprimap_el2",
+		"smidr_el1",
+		"id_aa64smfr0_el1",
+		"sm",
+		"za",
+
+		"gcscr_el1",
+		"gcspr_el1",
+		"gcscre0_el1",
+		"gcspr_el0",
+		"rcwmask_el1",
+		"rcwsmask_el1",
+
+		"brbinf0_el1",
+		"brbinf1_el1",
+		"brbinf2_el1",
+		"brbinf3_el1",
+		"brbinf4_el1",
+		"brbinf5_el1",
+		"brbinf6_el1",
+		"brbinf7_el1",
+		"brbinf8_el1",
+		"brbinf9_el1",
+		"brbinf10_el1",
+		"brbinf11_el1",
+		"brbinf12_el1",
+		"brbinf13_el1",
+		"brbinf14_el1",
+		"brbinf15_el1",
+		"brbinf16_el1",
+		"brbinf17_el1",
+		"brbinf18_el1",
+		"brbinf19_el1",
+		"brbinf20_el1",
+		"brbinf21_el1",
+		"brbinf22_el1",
+		"brbinf23_el1",
+		"brbinf24_el1",
+		"brbinf25_el1",
+		"brbinf26_el1",
+		"brbinf27_el1",
+		"brbinf28_el1",
+		"brbinf29_el1",
+		"brbinf30_el1",
+		"brbinf31_el1",
+		"brbsrc0_el1",
+		"brbsrc1_el1",
+		"brbsrc2_el1",
+		"brbsrc3_el1",
+		"brbsrc4_el1",
+		"brbsrc5_el1",
+		"brbsrc6_el1",
+		"brbsrc7_el1",
+		"brbsrc8_el1",
+		"brbsrc9_el1",
+		"brbsrc10_el1",
+		"brbsrc11_el1",
+		"brbsrc12_el1",
+		"brbsrc13_el1",
+		"brbsrc14_el1",
+		"brbsrc15_el1",
+		"brbsrc16_el1",
+		"brbsrc17_el1",
+		"brbsrc18_el1",
+		"brbsrc19_el1",
+		"brbsrc20_el1",
+		"brbsrc21_el1",
+		"brbsrc22_el1",
+		"brbsrc23_el1",
+		"brbsrc24_el1",
+		"brbsrc25_el1",
+		"brbsrc26_el1",
+		"brbsrc27_el1",
+		"brbsrc28_el1",
+		"brbsrc29_el1",
+		"brbsrc30_el1",
+		"brbsrc31_el1",
+		"brbtgt0_el1",
+		"brbtgt1_el1",
+		"brbtgt2_el1",
+		"brbtgt3_el1",
+		"brbtgt4_el1",
+		"brbtgt5_el1",
+		"brbtgt6_el1",
+		"brbtgt7_el1",
+		"brbtgt8_el1",
+		"brbtgt9_el1",
+		"brbtgt10_el1",
+		"brbtgt11_el1",
+		"brbtgt12_el1",
+		"brbtgt13_el1",
+		"brbtgt14_el1",
+		"brbtgt15_el1",
+		"brbtgt16_el1",
+		"brbtgt17_el1",
+		"brbtgt18_el1",
+		"brbtgt19_el1",
+		"brbtgt20_el1",
+		"brbtgt21_el1",
+		"brbtgt22_el1",
+		"brbtgt23_el1",
+		"brbtgt24_el1",
+		"brbtgt25_el1",
+		"brbtgt26_el1",
+		"brbtgt27_el1",
+		"brbtgt28_el1",
+		"brbtgt29_el1",
+		"brbtgt30_el1",
+		"brbtgt31_el1",
+		"brbcr_el1",
+		"brbcr_el2",
+		"brbcr_el12",
+		"brbfcr_el1",
+		"brbts_el1",
+		"brbinfinj_el1",
+		"brbsrcinj_el1",
+		"brbtgtinj_el1",
+		"brbidr0_el1",
+		"iall",
+		"inj",
+
 		"END_REG",
 	}[s]
 }
@@ -5305,6 +7087,9 @@ const (
 	LABEL
 	CONDITION
 	IMPLEMENTATION_SPECIFIC
+	SVE_PATTERN
+	SME_TILE
+	SME_TILE_LIST
 )
 
 type Register uint32
@@ -5607,6 +7392,94 @@ const (
 	REG_PF29
 	REG_PF30
 	REG_PF31
+	REG_Z0
+	REG_Z1
+	REG_Z2
+	REG_Z3
+	REG_Z4
+	REG_Z5
+	REG_Z6
+	REG_Z7
+	REG_Z8
+	REG_Z9
+	REG_Z10
+	REG_Z11
+	REG_Z12
+	REG_Z13
+	REG_Z14
+	REG_Z15
+	REG_Z16
+	REG_Z17
+	REG_Z18
+	REG_Z19
+	REG_Z20
+	REG_Z21
+	REG_Z22
+	REG_Z23
+	REG_Z24
+	REG_Z25
+	REG_Z26
+	REG_Z27
+	REG_Z28
+	REG_Z29
+	REG_Z30
+	REG_Z31
+	REG_P0
+	REG_P1
+	REG_P2
+	REG_P3
+	REG_P4
+	REG_P5
+	REG_P6
+	REG_P7
+	REG_P8
+	REG_P9
+	REG_P10
+	REG_P11
+	REG_P12
+	REG_P13
+	REG_P14
+	REG_P15
+	REG_PN0
+	REG_PN1
+	REG_PN2
+	REG_PN3
+	REG_PN4
+	REG_PN5
+	REG_PN6
+	REG_PN7
+	REG_PN8
+	REG_PN9
+	REG_PN10
+	REG_PN11
+	REG_PN12
+	REG_PN13
+	REG_PN14
+	REG_PN15
+	REG_ZA
+	REG_ZA0
+	REG_ZA1
+	REG_ZA2
+	REG_ZA3
+	REG_ZA4
+	REG_ZA5
+	REG_ZA6
+	REG_ZA7
+	REG_ZA8
+	REG_ZA9
+	REG_ZA10
+	REG_ZA11
+	REG_ZA12
+	REG_ZA13
+	REG_ZA14
+	REG_ZA15
+	REG_ZT0
+	REG_PLDKEEP
+	REG_PSTKEEP
+	REG_PLDSTRM
+	REG_PSTSTRM
+	REG_FLAGS // PSTATE.NZCV, only reported by RegsRead and RegsWritten
+	REG_FFR   // SVE first fault register, only reported by RegsRead and RegsWritten
 	REG_END
 )
 
@@ -5651,11 +7524,25 @@ func (r Register) String() string {
 		"plil3keep", "plil3strm", "#14", "#15",
 		"pstl1keep", "pstl1strm", "pstl2keep", "pstl2strm",
 		"pstl3keep", "pstl3strm",
-		"#23", "#24", "#25", "#26", "#27", "#28", "#29", "#30", "#31",
+		"#22", "#23", "#24", "#25", "#26", "#27", "#28", "#29", "#30", "#31",
+		"z0", "z1", "z2", "z3", "z4", "z5", "z6", "z7",
+		"z8", "z9", "z10", "z11", "z12", "z13", "z14", "z15",
+		"z16", "z17", "z18", "z19", "z20", "z21", "z22", "z23",
+		"z24", "z25", "z26", "z27", "z28", "z29", "z30", "z31",
+		"p0", "p1", "p2", "p3", "p4", "p5", "p6", "p7",
+		"p8", "p9", "p10", "p11", "p12", "p13", "p14", "p15",
+		"pn0", "pn1", "pn2", "pn3", "pn4", "pn5", "pn6", "pn7",
+		"pn8", "pn9", "pn10", "pn11", "pn12", "pn13", "pn14", "pn15",
+		"za",
+		"za0", "za1", "za2", "za3", "za4", "za5", "za6", "za7",
+		"za8", "za9", "za10", "za11", "za12", "za13", "za14", "za15",
+		"zt0",
+		"pldkeep", "pstkeep", "pldstrm", "pststrm",
+		"nzcv", "ffr",
 	}[r]
 }
 
-var regMap = [2][9][32]Register{
+var regMap = [2][13][32]Register{
 	{
 		{
 			REG_W0, REG_W1, REG_W2, REG_W3, REG_W4, REG_W5, REG_W6, REG_W7,
@@ -5702,6 +7589,26 @@ var regMap = [2][9][32]Register{
 			REG_PF8, REG_PF9, REG_PF10, REG_PF11, REG_PF12, REG_PF13, REG_PF14, REG_PF15,
 			REG_PF16, REG_PF17, REG_PF18, REG_PF19, REG_PF20, REG_PF21, REG_PF22, REG_PF23,
 			REG_PF24, REG_PF25, REG_PF26, REG_PF27, REG_PF28, REG_PF29, REG_PF30, REG_PF31,
+		}, {
+			REG_Z0, REG_Z1, REG_Z2, REG_Z3, REG_Z4, REG_Z5, REG_Z6, REG_Z7,
+			REG_Z8, REG_Z9, REG_Z10, REG_Z11, REG_Z12, REG_Z13, REG_Z14, REG_Z15,
+			REG_Z16, REG_Z17, REG_Z18, REG_Z19, REG_Z20, REG_Z21, REG_Z22, REG_Z23,
+			REG_Z24, REG_Z25, REG_Z26, REG_Z27, REG_Z28, REG_Z29, REG_Z30, REG_Z31,
+		}, {
+			REG_P0, REG_P1, REG_P2, REG_P3, REG_P4, REG_P5, REG_P6, REG_P7,
+			REG_P8, REG_P9, REG_P10, REG_P11, REG_P12, REG_P13, REG_P14, REG_P15,
+			REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE,
+			REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE,
+		}, {
+			REG_PN0, REG_PN1, REG_PN2, REG_PN3, REG_PN4, REG_PN5, REG_PN6, REG_PN7,
+			REG_PN8, REG_PN9, REG_PN10, REG_PN11, REG_PN12, REG_PN13, REG_PN14, REG_PN15,
+			REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE,
+			REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE,
+		}, {
+			REG_ZA0, REG_ZA1, REG_ZA2, REG_ZA3, REG_ZA4, REG_ZA5, REG_ZA6, REG_ZA7,
+			REG_ZA8, REG_ZA9, REG_ZA10, REG_ZA11, REG_ZA12, REG_ZA13, REG_ZA14, REG_ZA15,
+			REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE,
+			REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE,
 		},
 	}, {
 		{
@@ -5749,6 +7656,26 @@ var regMap = [2][9][32]Register{
 			REG_PF8, REG_PF9, REG_PF10, REG_PF11, REG_PF12, REG_PF13, REG_PF14, REG_PF15,
 			REG_PF16, REG_PF17, REG_PF18, REG_PF19, REG_PF20, REG_PF21, REG_PF22, REG_PF23,
 			REG_PF24, REG_PF25, REG_PF26, REG_PF27, REG_PF28, REG_PF29, REG_PF30, REG_PF31,
+		}, {
+			REG_Z0, REG_Z1, REG_Z2, REG_Z3, REG_Z4, REG_Z5, REG_Z6, REG_Z7,
+			REG_Z8, REG_Z9, REG_Z10, REG_Z11, REG_Z12, REG_Z13, REG_Z14, REG_Z15,
+			REG_Z16, REG_Z17, REG_Z18, REG_Z19, REG_Z20, REG_Z21, REG_Z22, REG_Z23,
+			REG_Z24, REG_Z25, REG_Z26, REG_Z27, REG_Z28, REG_Z29, REG_Z30, REG_Z31,
+		}, {
+			REG_P0, REG_P1, REG_P2, REG_P3, REG_P4, REG_P5, REG_P6, REG_P7,
+			REG_P8, REG_P9, REG_P10, REG_P11, REG_P12, REG_P13, REG_P14, REG_P15,
+			REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE,
+			REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE,
+		}, {
+			REG_PN0, REG_PN1, REG_PN2, REG_PN3, REG_PN4, REG_PN5, REG_PN6, REG_PN7,
+			REG_PN8, REG_PN9, REG_PN10, REG_PN11, REG_PN12, REG_PN13, REG_PN14, REG_PN15,
+			REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE,
+			REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE,
+		}, {
+			REG_ZA0, REG_ZA1, REG_ZA2, REG_ZA3, REG_ZA4, REG_ZA5, REG_ZA6, REG_ZA7,
+			REG_ZA8, REG_ZA9, REG_ZA10, REG_ZA11, REG_ZA12, REG_ZA13, REG_ZA14, REG_ZA15,
+			REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE,
+			REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE, REG_NONE,
 		},
 	},
 }
@@ -5763,6 +7690,10 @@ const (
 	REG_D_BASE  = 6
 	REG_Q_BASE  = 7
 	REG_PF_BASE = 8
+	REG_Z_BASE  = 9
+	REG_P_BASE  = 10
+	REG_PN_BASE = 11
+	REG_ZA_BASE = 12
 
 	REGSET_SP = 0
 	REGSET_ZR = 1
@@ -5963,6 +7894,104 @@ func (c Condition) String() string {
 
 // #define INVERT_CONDITION(N) ((N)^1)
 
+type PredicateQualifier uint32
+
+const (
+	PRED_NONE PredicateQualifier = iota
+	PRED_ZERO
+	PRED_MERGE
+)
+
+func (p PredicateQualifier) String() string {
+	return []string{
+		"",
+		"/z",
+		"/m",
+	}[p]
+}
+
+type SliceDirection uint32
+
+const (
+	SLICE_NONE SliceDirection = iota
+	SLICE_HORIZONTAL
+	SLICE_VERTICAL
+)
+
+func (d SliceDirection) String() string {
+	return []string{
+		"",
+		"h",
+		"v",
+	}[d]
+}
+
+// MopsPhase is the position of a memory copy/set instruction within its
+// prologue, main and epilogue sequence
+type MopsPhase uint32
+
+const (
+	MOPS_NONE MopsPhase = iota
+	MOPS_PROLOGUE
+	MOPS_MAIN
+	MOPS_EPILOGUE
+)
+
+func (p MopsPhase) String() string {
+	return []string{
+		"",
+		"prologue",
+		"main",
+		"epilogue",
+	}[p]
+}
+
+type SvePattern uint32
+
+const (
+	SVE_POW2 SvePattern = iota
+	SVE_VL1
+	SVE_VL2
+	SVE_VL3
+	SVE_VL4
+	SVE_VL5
+	SVE_VL6
+	SVE_VL7
+	SVE_VL8
+	SVE_VL16
+	SVE_VL32
+	SVE_VL64
+	SVE_VL128
+	SVE_VL256
+	SVE_MUL4 SvePattern = 29
+	SVE_MUL3 SvePattern = 30
+	SVE_ALL  SvePattern = 31
+	// SVE_VLX2 and SVE_VLX4 are the predicate-as-counter vector length multipliers
+	SVE_VLX2 SvePattern = 32
+	SVE_VLX4 SvePattern = 33
+)
+
+func (p SvePattern) String() string {
+	switch {
+	case p <= SVE_VL256:
+		return []string{
+			"pow2", "vl1", "vl2", "vl3", "vl4", "vl5", "vl6", "vl7",
+			"vl8", "vl16", "vl32", "vl64", "vl128", "vl256",
+		}[p]
+	case p == SVE_MUL4:
+		return "mul4"
+	case p == SVE_MUL3:
+		return "mul3"
+	case p == SVE_ALL:
+		return "all"
+	case p == SVE_VLX2:
+		return "vlx2"
+	case p == SVE_VLX4:
+		return "vlx4"
+	}
+	return fmt.Sprintf("#%d", uint32(p))
+}
+
 type ShiftType uint32
 
 const (
@@ -6001,6 +8030,71 @@ var (
 	notMemoryOperand             = errors.New("not memory operand")
 )
 
+// Causes of a DecodeError, use errors.Is to test for them
+var (
+	// ErrUnallocated the word is in the architecturally unallocated encoding space
+	ErrUnallocated = errors.New("unallocated encoding")
+	// ErrReserved the word is in an allocated group, but the encoding is reserved,
+	// unallocated within the group or not supported by the decoder
+	ErrReserved = errors.New("reserved encoding")
+	// ErrInternal the decoder failed on the word, this is a library bug
+	ErrInternal = errors.New("internal decoder error")
+	// ErrFeature the instruction needs features outside of Options.Arch and
+	// Options.Features
+	ErrFeature = errors.New("instruction needs unavailable features")
+)
+
+// DecodeStage is the step of turning an instruction word into text that failed
+type DecodeStage uint32
+
+const (
+	STAGE_DECODE DecodeStage = iota
+	STAGE_FORMAT
+)
+
+func (s DecodeStage) String() string {
+	return []string{
+		"decode",
+		"format",
+	}[s]
+}
+
+// DecodeError describes an instruction word that could not be decoded or formatted
+type DecodeError struct {
+	Raw     uint32
+	Address uint64
+	Group   Group
+	Stage   DecodeStage
+	Err     error // ErrUnallocated, ErrReserved, ErrInternal or ErrFeature, possibly wrapped
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("failed to %s instruction 0x%08x at %#x: %v", e.Stage, e.Raw, e.Address, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// rejectedError is an ErrUnallocated or ErrReserved cause that keeps the reason
+// the decoder rejected the word, errors.Is matches both
+type rejectedError struct {
+	kind error
+	err  error
+}
+
+func (e *rejectedError) Error() string {
+	return fmt.Sprintf("%v: %v", e.kind, e.err)
+}
+
+func (e *rejectedError) Is(target error) bool {
+	return target == e.kind
+}
+
+func (e *rejectedError) Unwrap() error {
+	return e.err
+}
+
 type Group uint32
 
 const (
@@ -6011,9 +8105,28 @@ const (
 	GROUP_DATA_PROCESSING_REG
 	GROUP_DATA_PROCESSING_SIMD
 	GROUP_DATA_PROCESSING_SIMD2
+	GROUP_SVE
+	GROUP_SME
+	GROUP_APPLE
 	END_GROUP
 )
 
+// Vendor selects the implementation defined encodings to decode in addition
+// to the architectural ones
+type Vendor uint32
+
+const (
+	VENDOR_NONE Vendor = iota
+	VENDOR_APPLE
+)
+
+func (v Vendor) String() string {
+	return []string{
+		"none",
+		"apple",
+	}[v]
+}
+
 type InstructionOperand struct {
 	OpClass        OperandClass
 	strRepr        string
@@ -6031,6 +8144,12 @@ type InstructionOperand struct {
 	SignedImm      uint32
 	Rotation       uint32
 	HasRotation    bool
+	PredQual       PredicateQualifier // SVE governing predicate /z or /m
+	MulVl          bool               // SVE vector length scaled immediate offset
+	IndexReg       uint32             // SVE/SME vector select register, printed as [<Wv>, <imm>]
+	Slice          SliceDirection     // SME ZA tile slice orientation
+	Writeback      bool               // base or count register is updated, printed with a trailing !
+	immBits        uint32             // width of the Value of an immediate
 }
 
 func (op InstructionOperand) String() string {
@@ -6071,13 +8190,23 @@ type Instruction struct {
 	operation Operation
 	operands  [MAX_OPERANDS]InstructionOperand
 	// operands []InstructionOperand
+	vendor    Vendor
+	bigEndian bool
 }
 
 func (i *Instruction) Raw() uint32 {
 	return i.raw
 }
+
+// OpCodes returns the bytes of the instruction in memory order
 func (i *Instruction) OpCodes() string {
-	return getOpCodeByteString(i.raw)
+	return getOpCodeByteString(i.raw, i.byteOrder())
+}
+func (i *Instruction) byteOrder() binary.ByteOrder {
+	if i.bigEndian {
+		return binary.BigEndian
+	}
+	return binary.LittleEndian
 }
 func (i *Instruction) Address() uint64 {
 	return i.address
@@ -6097,13 +8226,52 @@ func (i *Instruction) Operands() []InstructionOperand {
 	}
 	return ops
 }
+
+// String returns the disassembly of the instruction with hexadecimal immediates
+func (i *Instruction) String() string {
+	str, err := format(i, false)
+	if err != nil {
+		return "<unknown>"
+	}
+	return str
+}
 func (i *Instruction) OpStr() string {
-	return fmt.Sprintf("%s%s%s%s%s",
+	return fmt.Sprintf("%s%s%s%s%s%s",
 		i.operands[0],
 		i.operands[1],
 		i.operands[2],
 		i.operands[3],
-		i.operands[4])
+		i.operands[4],
+		i.operands[5])
+}
+
+// MopsPhase returns whether the instruction is the prologue, main or epilogue
+// part of a CPY*, CPYF*, SET* or SETG* memory copy/set sequence
+func (i *Instruction) MopsPhase() MopsPhase {
+	if i.operation == ARM64_UNDEFINED || i.raw&0xfb200c00 != 0x19000400 {
+		return MOPS_NONE
+	}
+	if ExtractBits(i.raw, 22, 2) == 3 {
+		return MopsPhase(ExtractBits(i.raw, 14, 2) + 1)
+	}
+	return MopsPhase(ExtractBits(i.raw, 22, 2) + 1)
+}
+
+// InMopsGroup reports whether the instruction and other are parts of the same
+// memory copy/set sequence, i.e. they only differ in their phase and use the
+// same variant, options and registers
+func (i *Instruction) InMopsGroup(other *Instruction) bool {
+	if other == nil || i.MopsPhase() == MOPS_NONE || other.MopsPhase() == MOPS_NONE {
+		return false
+	}
+	return i.raw&^mopsPhaseMask(i.raw) == other.raw&^mopsPhaseMask(other.raw)
+}
+
+func mopsPhaseMask(raw uint32) uint32 {
+	if ExtractBits(raw, 22, 2) == 3 {
+		return 3 << 14
+	}
+	return 3 << 22
 }
 
 type MachoArm64RelocationType uint32
@@ -6167,9 +8335,9 @@ func signExtend64(value, bits uint64) uint64 {
 	return value
 }
 
-func getOpCodeByteString(opcode uint32) string {
+func getOpCodeByteString(opcode uint32, order binary.ByteOrder) string {
 	op := new(bytes.Buffer)
-	err := binary.Write(op, binary.LittleEndian, opcode)
+	err := binary.Write(op, order, opcode)
 	if err != nil {
 		return ""
 	}
@@ -0,0 +1,200 @@
+package arm64
+
+import (
+	"math"
+	"math/bits"
+)
+
+//---------------------------------------------
+// Immediate values
+//---------------------------------------------
+
+// ValueKind is the numeric type of an immediate operand's value
+type ValueKind uint32
+
+const (
+	VALUE_NONE  ValueKind = iota // the operand is not an immediate
+	VALUE_INT                    // a signed integer, e.g. a negative offset or #-0x1
+	VALUE_UINT                   // an unsigned integer, bit mask or address
+	VALUE_FLOAT                  // a floating-point constant, e.g. of FMOV
+)
+
+func (k ValueKind) String() string {
+	return []string{"none", "int", "uint", "float"}[k]
+}
+
+// Value is the fully applied numeric value of an immediate operand, the
+// immediate with its LSL or MSL shift applied and truncated to Bits
+type Value struct {
+	Kind   ValueKind
+	Bits   uint32  // the width of the register or vector element it is used with, or of its field
+	signed int64   // the value sign extended from Bits
+	fp     float64 // the value of a VALUE_FLOAT
+}
+
+// Int returns the value sign extended from Bits, a float is truncated
+func (v Value) Int() int64 {
+	if v.Kind == VALUE_FLOAT {
+		return int64(v.fp)
+	}
+	return v.signed
+}
+
+// Uint returns the value zero extended from Bits, a float is truncated
+func (v Value) Uint() uint64 {
+	if v.Kind == VALUE_FLOAT {
+		return uint64(v.Int())
+	}
+	return uint64(v.signed) & (^uint64(0) >> (64 - v.Bits))
+}
+
+// Float returns the value as a float64, an integer is converted
+func (v Value) Float() float64 {
+	switch v.Kind {
+	case VALUE_FLOAT:
+		return v.fp
+	case VALUE_INT:
+		return float64(v.signed)
+	}
+	return float64(v.Uint())
+}
+
+// Value returns the numeric value of an IMM32, IMM64, FIMM32 or LABEL operand,
+// or false for any other operand. The immediates are already expanded when
+// decoded: the bit mask of a logical immediate, the IEEE 754 constant of an
+// FMOV #<imm> and the page address of ADRP. Value also applies the shift of
+// e.g. MOVK x0, #0x1234, LSL #48 or ADD x0, x1, #0x1, LSL #12.
+//
+// Bits is the width of the register the immediate is used with, e.g. 32 for
+// TST w0, #0x1 and 64 for FMOV d0, #1.5, or of its vector elements, e.g. 32 for
+// AND z0.s, z0.s, #0xff. Immediates that are fields of their own have the width
+// of the field instead, see immediateBits. Kind follows the disassembly, an
+// operand printed as #-0x1 is VALUE_INT.
+func (op InstructionOperand) Value() (Value, bool) {
+	v := Value{Bits: op.immBits}
+	switch op.OpClass {
+	case FIMM32:
+		v.Kind = VALUE_FLOAT
+		v.fp = float64(math.Float32frombits(uint32(op.Immediate)))
+		return v, true
+	case LABEL:
+		return Value{Kind: VALUE_UINT, Bits: 64, signed: int64(op.Immediate)}, true
+	case IMM32:
+		v.Kind = VALUE_UINT
+		if op.SignedImm == 1 || int32(op.Immediate) < 0 {
+			v.Kind = VALUE_INT
+		}
+	case IMM64:
+		v.Kind = VALUE_UINT
+		if op.SignedImm == 1 {
+			v.Kind = VALUE_INT
+		}
+	default:
+		return Value{}, false
+	}
+	raw := op.Immediate
+	switch op.ShiftType {
+	case SHIFT_LSL:
+		raw <<= op.ShiftValue
+	case SHIFT_MSL:
+		raw = raw<<op.ShiftValue | (1<<op.ShiftValue - 1)
+	}
+	// sign extend from Bits, the immediate is stored in two's complement
+	shift := 64 - v.Bits
+	v.signed = int64(raw<<shift) >> shift
+	return v, true
+}
+
+// setImmediateBits records the width of each immediate operand of i, which
+// its Value reports as Bits
+func (i *Instruction) setImmediateBits() {
+	for n := range i.operands {
+		switch i.operands[n].OpClass {
+		case IMM32, IMM64, FIMM32:
+			i.operands[n].immBits = i.immediateBits(n)
+		}
+	}
+}
+
+// immediateBits returns the width of the immediate operand n of i. The flags
+// of a conditional compare, the immediate it compares with and the immediates
+// of exceptions and PSTATE writes are fields of a fixed width. Shift amounts,
+// rotations and fixed-point fraction bits count bits of the widest register or
+// vector element of i, from 0 up to its size, and a tested bit number counts
+// them up to its size less one. Any other immediate has the width of the first
+// register or its vector elements, or is 32 bits wide (64 for an IMM64) when i
+// has no register operand.
+func (i *Instruction) immediateBits(n int) uint32 {
+	switch i.operation {
+	case ARM64_CCMN, ARM64_CCMP, ARM64_FCCMP, ARM64_FCCMPE:
+		if n == 1 {
+			return 5 // #<imm>
+		}
+		return 4 // #<nzcv>
+	case ARM64_RMIF:
+		if n == 1 {
+			return 6 // #<shift>
+		}
+		return 4 // #<mask>
+	case ARM64_ADDG, ARM64_SUBG:
+		if n == 3 {
+			return 4 // #<uimm4> tag offset
+		}
+	case ARM64_BRK, ARM64_DCPS1, ARM64_DCPS2, ARM64_DCPS3, ARM64_HLT, ARM64_HVC, ARM64_SMC, ARM64_SVC:
+		return 16
+	case ARM64_HINT:
+		return 7
+	case ARM64_CLREX, ARM64_DMB, ARM64_DSB, ARM64_ISB, ARM64_MSR:
+		return 4
+	case ARM64_TBNZ, ARM64_TBZ:
+		return uint32(bits.Len32(i.widestBits() - 1))
+	case ARM64_ASR, ARM64_ASRD, ARM64_BFC, ARM64_BFI, ARM64_BFM, ARM64_BFXIL, ARM64_EXTR, ARM64_FCVTZS,
+		ARM64_FCVTZU, ARM64_LSL, ARM64_LSR, ARM64_ROR, ARM64_RSHRN, ARM64_RSHRN2, ARM64_RSHRNB,
+		ARM64_RSHRNT, ARM64_SBFIZ, ARM64_SBFM, ARM64_SBFX, ARM64_SCVTF, ARM64_SHL, ARM64_SHLL,
+		ARM64_SHLL2, ARM64_SHRN, ARM64_SHRN2, ARM64_SHRNB, ARM64_SHRNT, ARM64_SLI, ARM64_SQRSHRN,
+		ARM64_SQRSHRN2, ARM64_SQRSHRNB, ARM64_SQRSHRNT, ARM64_SQRSHRUN, ARM64_SQRSHRUN2,
+		ARM64_SQRSHRUNB, ARM64_SQRSHRUNT, ARM64_SQSHL, ARM64_SQSHLU, ARM64_SQSHRN, ARM64_SQSHRN2,
+		ARM64_SQSHRNB, ARM64_SQSHRNT, ARM64_SQSHRUN, ARM64_SQSHRUN2, ARM64_SQSHRUNB, ARM64_SQSHRUNT,
+		ARM64_SRI, ARM64_SRSHR, ARM64_SRSRA, ARM64_SSHLL, ARM64_SSHLL2, ARM64_SSHLLB, ARM64_SSHLLT,
+		ARM64_SSHR, ARM64_SSRA, ARM64_UBFIZ, ARM64_UBFM, ARM64_UBFX, ARM64_UCVTF, ARM64_UQRSHRN,
+		ARM64_UQRSHRN2, ARM64_UQRSHRNB, ARM64_UQRSHRNT, ARM64_UQSHL, ARM64_UQSHRN, ARM64_UQSHRN2,
+		ARM64_UQSHRNB, ARM64_UQSHRNT, ARM64_URSHR, ARM64_URSRA, ARM64_USHLL, ARM64_USHLL2,
+		ARM64_USHLLB, ARM64_USHLLT, ARM64_USHR, ARM64_USRA, ARM64_XAR:
+		return uint32(bits.Len32(i.widestBits()))
+	}
+	for _, reg := range i.operands {
+		if reg.OpClass == REG {
+			if size := regBits(reg); size != 0 {
+				return size
+			}
+			break
+		}
+	}
+	if i.operands[n].OpClass == IMM64 {
+		return 64
+	}
+	return 32
+}
+
+// widestBits returns the width of the widest register or vector element of i
+func (i *Instruction) widestBits() uint32 {
+	var widest uint32
+	for _, reg := range i.operands {
+		if size := regBits(reg); reg.OpClass == REG && size > widest {
+			widest = size
+		}
+	}
+	return widest
+}
+
+// regBits returns the width of the REG operand reg or its vector elements, 0
+// for a register wider than 64 bits such as q0
+func regBits(reg InstructionOperand) uint32 {
+	if reg.ElementSize != 0 {
+		return 8 * reg.ElementSize
+	}
+	if size := getRegisterSize(Register(reg.Reg[0])); size <= 8 {
+		return 8 * size
+	}
+	return 0
+}